@@ -2,20 +2,17 @@ import { FetchWithAuth, Response } from "@/lib/api"
 import type { Transaction, transactionSearchParams, CreateTransactionParams } from "@/types/transaction"
 
 export const GetAllTransactions = async (params?: transactionSearchParams): Promise<Response<{ transactions: Transaction[] }>> => {
-  let url = "/transactions"
+  // 재고내역 목록은 기본 페이지 크기만 조회되므로 최신 순으로 요청
+  const queryParams = new URLSearchParams({ sort: "id", order: "desc" })
 
   // Add query parameters if they exist
   if (params) {
-    const queryParams = new URLSearchParams()
     if (params.inventory_id) queryParams.append("inventory_id", params.inventory_id.toString())
     if (params.type) queryParams.append("type", params.type)
-
-    const queryString = queryParams.toString()
-    if (queryString) {
-      url += `?${queryString}`
-    }
   }
 
+  const url = `/transactions?${queryParams.toString()}`
+
   const res = await FetchWithAuth(url, {
     method: "GET",
   })
@@ -47,9 +44,10 @@ export const CreateTransaction = async (data: CreateTransactionParams): Promise<
   }
 }
 
-export const DeleteTransaction = async (id: number): Promise<Response<null>> => {
-  const res = await FetchWithAuth(`/transactions/${id}`, {
-    method: "DELETE",
+// 재고내역은 삭제하지 않고 반대 방향의 재고내역을 생성해 취소
+export const ReverseTransaction = async (id: number): Promise<Response<{ transactions: Transaction[] }>> => {
+  const res = await FetchWithAuth(`/transactions/${id}/reverse`, {
+    method: "POST",
   })
   return {
     data: res.data,
@@ -47,7 +47,29 @@ func Migration() error {
 		&models.User{},
 		&models.Warehouse{},
 		&models.Product{},
+		&models.ProductUnit{},
+		&models.Category{},
+		&models.ProductAttribute{},
 		&models.Inventory{},
 		&models.Transaction{},
+		&models.Order{},
+		&models.OrderItem{},
+		&models.TransferOrder{},
+		&models.TransferOrderItem{},
+		&models.Reservation{},
+		&models.Lot{},
+		&models.TransactionLot{},
+		&models.SerialNumber{},
+		&models.BinLocation{},
+		&models.BinStock{},
+		&models.StockAlert{},
+		&models.Supplier{},
+		&models.PurchaseOrder{},
+		&models.PurchaseOrderLine{},
+		&models.Stocktake{},
+		&models.StocktakeLine{},
+		&models.CostLayer{},
+		&models.Barcode{},
+		&models.IdempotencyKey{},
 	)
 }
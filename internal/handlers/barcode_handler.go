@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type BarcodeHandler interface {
+	GetAllBarcodes(c *gin.Context)
+	CreateBarcode(c *gin.Context)
+	DeleteBarcode(c *gin.Context)
+	ScanCode(c *gin.Context)
+}
+
+type barcodeHandler struct {
+	barcodeService services.BarcodeService
+}
+
+func NewBarcodeHandler(barcodeService services.BarcodeService) BarcodeHandler {
+	return &barcodeHandler{
+		barcodeService: barcodeService,
+	}
+}
+
+func (h *barcodeHandler) GetAllBarcodes(c *gin.Context) {
+	search_filter := utils.GetBarcodeSearchQuery(c)
+
+	status, barcodes, err := h.barcodeService.FindAll(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"barcodes": barcodes,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (h *barcodeHandler) CreateBarcode(c *gin.Context) {
+	var createBarcodeDTO dto.CreateBarcodeDTO
+	if err := c.ShouldBindJSON(&createBarcodeDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := createBarcodeDTO.CheckCreateBarcodeDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, barcode, err := h.barcodeService.Create(createBarcodeDTO.ToModel())
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"barcode": barcode,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (h *barcodeHandler) DeleteBarcode(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, err := h.barcodeService.Delete(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	utils.JSONResponse(c, status, nil, nil)
+}
+
+// 스캔한 바코드(또는 SKU)로 제품과 창고별 재고 조회
+func (h *barcodeHandler) ScanCode(c *gin.Context) {
+	code := c.Param("code")
+	if code == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("code is required"))
+		return
+	}
+
+	status, result, err := h.barcodeService.Scan(code)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"scan": result,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
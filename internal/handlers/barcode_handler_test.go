@@ -0,0 +1,130 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupBarcode() (*gorm.DB, *gin.Engine) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	barcodeRepo := repositories.NewBarcodeRepository(db)
+	productRepo := repositories.NewProductRepository(db)
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	barcodeService := services.NewBarcodeService(barcodeRepo, productRepo, inventoryRepo)
+	barcodeHandler := handlers.NewBarcodeHandler(barcodeService)
+
+	router := gin.Default()
+	router.GET("/barcodes", barcodeHandler.GetAllBarcodes)
+	router.POST("/barcodes", barcodeHandler.CreateBarcode)
+	router.DELETE("/barcodes/:id", barcodeHandler.DeleteBarcode)
+	router.GET("/scan/:code", barcodeHandler.ScanCode)
+	return db, router
+}
+
+func TestCreateBarcodeAndScan(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router := setupBarcode()
+
+	product, err := CreateTestProduct(db, "Barcode Product", "BC-001")
+	if err != nil {
+		t.Fatalf("Failed to create test product: %v", err)
+	}
+	if err := db.Create(&models.ProductUnit{ProductID: product.ID, Name: models.UNIT_CASE, Factor: 12}).Error; err != nil {
+		t.Fatalf("Failed to create product unit: %v", err)
+	}
+	other, err := CreateTestProduct(db, "Other Product", "BC-002")
+	if err != nil {
+		t.Fatalf("Failed to create test product: %v", err)
+	}
+
+	warehouse1, err := CreateTestWarehouse(db, "Warehouse 1", "Location 1")
+	if err != nil {
+		t.Fatalf("Failed to create test warehouse: %v", err)
+	}
+	warehouse2, err := CreateTestWarehouse(db, "Warehouse 2", "Location 2")
+	if err != nil {
+		t.Fatalf("Failed to create test warehouse: %v", err)
+	}
+	if _, err := CreateTestInventory(db, product.ID, warehouse1.ID, 30); err != nil {
+		t.Fatalf("Failed to create test inventory: %v", err)
+	}
+	if _, err := CreateTestInventory(db, product.ID, warehouse2.ID, 12); err != nil {
+		t.Fatalf("Failed to create test inventory: %v", err)
+	}
+
+	for _, tc := range []struct {
+		payload  dto.CreateBarcodeDTO
+		expected int
+	}{
+		{dto.CreateBarcodeDTO{ProductID: product.ID, Code: "4006381333931", Symbology: models.BARCODE_EAN13}, http.StatusCreated},
+		{dto.CreateBarcodeDTO{ProductID: product.ID, Code: "4006381333932", Symbology: models.BARCODE_EAN13}, http.StatusBadRequest}, // 체크 디지트 오류
+		{dto.CreateBarcodeDTO{ProductID: product.ID, Code: "CASE-BC-001", Unit: models.UNIT_CASE}, http.StatusCreated},
+		{dto.CreateBarcodeDTO{ProductID: product.ID, Code: "PALLET-BC-001", Unit: models.UNIT_PALLET}, http.StatusBadRequest}, // 등록되지 않은 단위
+		{dto.CreateBarcodeDTO{ProductID: other.ID, Code: "4006381333931", Symbology: models.BARCODE_EAN13}, http.StatusConflict},
+		{dto.CreateBarcodeDTO{ProductID: 999, Code: "UNKNOWN-001"}, http.StatusBadRequest},
+	} {
+		rr := postTransferOrder(router, t, "/barcodes", tc.payload)
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d for %s, got %d", tc.expected, tc.payload.Code, rr.Code)
+		}
+	}
+
+	for _, tc := range []struct {
+		code     string
+		expected int
+		unit     string
+		factor   int
+	}{
+		{"4006381333931", http.StatusOK, models.UNIT_EACH, 1},
+		{"CASE-BC-001", http.StatusOK, models.UNIT_CASE, 12},
+		{"BC-001", http.StatusOK, models.UNIT_EACH, 1}, // 바코드가 없으면 SKU 로 조회
+		{"NOT-FOUND", http.StatusNotFound, "", 0},
+	} {
+		req, err := http.NewRequest("GET", "/scan/"+tc.code, nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d for %s, got %d", tc.expected, tc.code, rr.Code)
+		}
+		if tc.expected != http.StatusOK {
+			continue
+		}
+
+		var resp struct {
+			Response
+			Data struct {
+				Scan models.ScanResult `json:"scan"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+
+		scan := resp.Data.Scan
+		if scan.Product == nil || scan.Product.ID != product.ID {
+			t.Fatalf("Expected product %d for %s, got %+v", product.ID, tc.code, scan.Product)
+		}
+		if scan.Unit != tc.unit || scan.UnitFactor != tc.factor {
+			t.Fatalf("Expected unit %s x%d for %s, got %s x%d", tc.unit, tc.factor, tc.code, scan.Unit, scan.UnitFactor)
+		}
+		if len(scan.Inventories) != 2 || scan.TotalQuantity != 42 {
+			t.Fatalf("Expected 2 inventories with total 42, got %d inventories with total %d", len(scan.Inventories), scan.TotalQuantity)
+		}
+	}
+}
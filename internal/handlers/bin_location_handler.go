@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type BinLocationHandler interface {
+	GetAllBinLocations(c *gin.Context)
+	GetBinLocation(c *gin.Context)
+	CreateBinLocation(c *gin.Context)
+	DeleteBinLocation(c *gin.Context)
+	MoveBinStock(c *gin.Context)
+}
+
+type binLocationHandler struct {
+	binLocationService services.BinLocationService
+}
+
+func NewBinLocationHandler(binLocationService services.BinLocationService) BinLocationHandler {
+	return &binLocationHandler{
+		binLocationService: binLocationService,
+	}
+}
+
+func (b *binLocationHandler) GetAllBinLocations(c *gin.Context) {
+	search_filter := utils.GetBinLocationSearchQuery(c)
+
+	status, binLocations, err := b.binLocationService.FindAll(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"bin_locations": binLocations,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 보관 위치와 위치에 보관 중인 재고 ( bin_stocks ) 조회
+func (b *binLocationHandler) GetBinLocation(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, binLocation, err := b.binLocationService.FindByID(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"bin_location": binLocation,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (b *binLocationHandler) CreateBinLocation(c *gin.Context) {
+	var createBinLocationDTO dto.CreateBinLocationDTO
+	if err := c.ShouldBindJSON(&createBinLocationDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := createBinLocationDTO.CheckCreateBinLocationDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, binLocation, err := b.binLocationService.Create(createBinLocationDTO.ToModel())
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"bin_location": binLocation,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (b *binLocationHandler) DeleteBinLocation(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, err := b.binLocationService.Delete(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	utils.JSONResponse(c, status, nil, nil)
+}
+
+func (b *binLocationHandler) MoveBinStock(c *gin.Context) {
+	ctx := c.Request.Context()
+	var moveBinStockDTO dto.MoveBinStockDTO
+	if err := c.ShouldBindJSON(&moveBinStockDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := moveBinStockDTO.CheckMoveBinStockDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, binStocks, err := b.binLocationService.Move(
+		moveBinStockDTO.InventoryID,
+		moveBinStockDTO.FromBinLocationID,
+		moveBinStockDTO.ToBinLocationID,
+		moveBinStockDTO.Quantity,
+		ctx,
+	)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"bin_stocks": binStocks,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
@@ -0,0 +1,158 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupBinLocation() (*gorm.DB, *gin.Engine) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	transactionRepo := repositories.NewTransactionRepository(db)
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	warehouseRepo := repositories.NewWarehouseRepository(db)
+	lotRepo := repositories.NewLotRepository(db)
+	serialNumberRepo := repositories.NewSerialNumberRepository(db)
+	binLocationRepo := repositories.NewBinLocationRepository(db)
+	stockAlertRepo := repositories.NewStockAlertRepository(db)
+	costLayerRepo := repositories.NewCostLayerRepository(db)
+	transactionService := services.NewTransactionService(transactionRepo, inventoryRepo, warehouseRepo, lotRepo, serialNumberRepo, binLocationRepo, stockAlertRepo, costLayerRepo)
+	transactionHandler := handlers.NewTransactionHandler(transactionService)
+	binLocationService := services.NewBinLocationService(binLocationRepo, warehouseRepo, inventoryRepo)
+	binLocationHandler := handlers.NewBinLocationHandler(binLocationService)
+
+	router := gin.Default()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+	router.POST("/bin-locations", binLocationHandler.CreateBinLocation)
+	router.POST("/bin-locations/move", binLocationHandler.MoveBinStock)
+	router.GET("/bin-locations/:id", binLocationHandler.GetBinLocation)
+	router.DELETE("/bin-locations/:id", binLocationHandler.DeleteBinLocation)
+	return db, router
+}
+
+func requestBinLocation(t *testing.T, router *gin.Engine, method, url string, payload interface{}) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	if payload != nil {
+		if err := json.NewEncoder(&body).Encode(payload); err != nil {
+			t.Fatalf("Failed to marshal JSON payload: %v", err)
+		}
+	}
+
+	req, err := http.NewRequest(method, url, &body)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestCreateBinLocation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router := setupBinLocation()
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+
+	payload := dto.CreateBinLocationDTO{WarehouseID: 1, Zone: "A", Aisle: "01", Shelf: "03", Bin: "2"}
+	rr := requestBinLocation(t, router, "POST", "/bin-locations", payload)
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			BinLocation *models.BinLocation `json:"bin_location"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if resp.Data.BinLocation.Code != "A-01-03-2" {
+		t.Errorf("Expected code A-01-03-2, got %s", resp.Data.BinLocation.Code)
+	}
+
+	// 같은 창고에 같은 위치 중복 생성 불가
+	rr = requestBinLocation(t, router, "POST", "/bin-locations", payload)
+	if rr.Code != http.StatusConflict {
+		t.Errorf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+}
+
+func TestBinStockTransactionAndMove(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router := setupBinLocation()
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 0)
+	CreateTestBinLocation(db, 1, "A", "01")
+	CreateTestBinLocation(db, 1, "B", "01")
+
+	binA, binB := uint(1), uint(2)
+
+	rr := requestBinLocation(t, router, "POST", "/transactions", dto.CreateTransactionDTO{InventoryID: 1, Quantity: 10, Type: "IN", BinLocationID: &binA})
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	// 위치 재고보다 많이 출고 불가
+	rr = requestBinLocation(t, router, "POST", "/transactions", dto.CreateTransactionDTO{InventoryID: 1, Quantity: 3, Type: "OUT", BinLocationID: &binB})
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+
+	rr = requestBinLocation(t, router, "POST", "/bin-locations/move", dto.MoveBinStockDTO{InventoryID: 1, FromBinLocationID: binA, ToBinLocationID: binB, Quantity: 4})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	rr = requestBinLocation(t, router, "POST", "/transactions", dto.CreateTransactionDTO{InventoryID: 1, Quantity: 3, Type: "OUT", BinLocationID: &binB})
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	rr = requestBinLocation(t, router, "GET", "/bin-locations/2", nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			BinLocation *models.BinLocation `json:"bin_location"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if len(resp.Data.BinLocation.BinStocks) != 1 || resp.Data.BinLocation.BinStocks[0].Quantity != 1 {
+		t.Fatalf("Expected 1 unit in bin B, got %+v", resp.Data.BinLocation.BinStocks)
+	}
+
+	var inventory models.Inventory
+	db.First(&inventory, 1)
+	if inventory.Quantity != 7 {
+		t.Errorf("Expected inventory quantity 7, got %d", inventory.Quantity)
+	}
+
+	// 재고가 남아 있는 위치는 삭제 불가
+	rr = requestBinLocation(t, router, "DELETE", "/bin-locations/1", nil)
+	if rr.Code != http.StatusConflict {
+		t.Errorf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type CategoryHandler interface {
+	GetAllCategories(c *gin.Context)
+	GetCategory(c *gin.Context)
+	CreateCategory(c *gin.Context)
+	DeleteCategory(c *gin.Context)
+}
+
+type categoryHandler struct {
+	categoryService services.CategoryService
+}
+
+func NewCategoryHandler(categoryService services.CategoryService) CategoryHandler {
+	return &categoryHandler{
+		categoryService: categoryService,
+	}
+}
+
+// 검색 조건이 없으면 분류 트리 반환
+func (h *categoryHandler) GetAllCategories(c *gin.Context) {
+	search_filter := utils.GetCategorySearchQuery(c)
+
+	status, categories, err := h.categoryService.FindAll(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"categories": categories,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (h *categoryHandler) GetCategory(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, category, err := h.categoryService.FindByID(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"category": category,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (h *categoryHandler) CreateCategory(c *gin.Context) {
+	var createCategoryDTO dto.CreateCategoryDTO
+	if err := c.ShouldBindJSON(&createCategoryDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := createCategoryDTO.CheckCreateCategoryDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, category, err := h.categoryService.Create(createCategoryDTO.ToModel())
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"category": category,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (h *categoryHandler) DeleteCategory(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, err := h.categoryService.Delete(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	utils.JSONResponse(c, status, nil, nil)
+}
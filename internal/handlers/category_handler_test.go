@@ -0,0 +1,89 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupCategory() (*gorm.DB, *gin.Engine) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	categoryRepo := repositories.NewCategoryRepository(db)
+	productRepo := repositories.NewProductRepository(db)
+	categoryService := services.NewCategoryService(categoryRepo, productRepo)
+	categoryHandler := handlers.NewCategoryHandler(categoryService)
+
+	router := gin.Default()
+	router.GET("/categories", categoryHandler.GetAllCategories)
+	router.POST("/categories", categoryHandler.CreateCategory)
+	router.DELETE("/categories/:id", categoryHandler.DeleteCategory)
+	return db, router
+}
+
+func TestCreateCategoryTree(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	_, router := setupCategory()
+
+	apparel, shirts := uint(1), uint(2)
+	for _, tc := range []struct {
+		payload  dto.CreateCategoryDTO
+		expected int
+	}{
+		{dto.CreateCategoryDTO{Name: "Apparel"}, http.StatusCreated},
+		{dto.CreateCategoryDTO{Name: "Shirts", ParentID: &apparel}, http.StatusCreated},
+		{dto.CreateCategoryDTO{Name: "T-Shirts", ParentID: &shirts}, http.StatusCreated},
+		{dto.CreateCategoryDTO{Name: "Shirts", ParentID: &apparel}, http.StatusConflict},
+	} {
+		rr := postTransferOrder(router, t, "/categories", tc.payload)
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d, got %d", tc.expected, rr.Code)
+		}
+	}
+
+	req, err := http.NewRequest("GET", "/categories", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	var resp struct {
+		Response
+		Data struct {
+			Categories []models.Category `json:"categories"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	categories := resp.Data.Categories
+	if len(categories) != 1 || len(categories[0].Children) != 1 || len(categories[0].Children[0].Children) != 1 {
+		t.Fatalf("Expected Apparel > Shirts > T-Shirts tree, got %+v", categories)
+	}
+
+	// 하위 분류가 있는 분류는 삭제 불가
+	req, err = http.NewRequest("DELETE", "/categories/1", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusConflict {
+		t.Errorf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+}
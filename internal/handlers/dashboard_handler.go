@@ -52,6 +52,12 @@ func (d *dashboardHandler) GetDashboard(c *gin.Context) {
 		return
 	}
 
+	status, in_transit_quantities, err := d.dashboardService.GetInTransitQuantities()
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
 	res_data := gin.H{
 		"warehouse": gin.H {
 			"count":       warehouse_count,
@@ -69,6 +75,7 @@ func (d *dashboardHandler) GetDashboard(c *gin.Context) {
 			"count":       zero_quantity_count,
 		},
 		"recent_transactions": recent_transactions,
+		"in_transit": in_transit_quantities,
 	}
 	
 	utils.JSONResponse(c, status, res_data, nil)
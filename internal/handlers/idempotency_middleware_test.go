@@ -0,0 +1,208 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/middleware"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func postWithIdempotencyKey(router *gin.Engine, t *testing.T, path, key string, payload interface{}) *httptest.ResponseRecorder {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	req, err := http.NewRequest("POST", path, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(middleware.IDEMPOTENCY_KEY_HEADER, key)
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestIdempotencyKeyReplaysCreateTransaction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, _, transactionHandler := setupTransaction()
+
+	user, err := CreateTestUser(db)
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	product, err := CreateTestProduct(db, "Idempotency Product", "IDEM-001")
+	if err != nil {
+		t.Fatalf("Failed to create test product: %v", err)
+	}
+	warehouse, err := CreateTestWarehouse(db, "Idempotency Warehouse", "Location")
+	if err != nil {
+		t.Fatalf("Failed to create test warehouse: %v", err)
+	}
+	inventory, err := CreateTestInventory(db, product.ID, warehouse.ID, 10)
+	if err != nil {
+		t.Fatalf("Failed to create test inventory: %v", err)
+	}
+
+	// AuthMiddleware 대신 사용자 ID 설정
+	router.Use(func(c *gin.Context) {
+		c.Set("userID", user.ID)
+		c.Next()
+	}, middleware.IdempotencyMiddleware(repositories.NewIdempotencyKeyRepository(db)))
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+
+	payload := dto.CreateTransactionDTO{InventoryID: inventory.ID, Quantity: 5, Type: "IN"}
+
+	first := postWithIdempotencyKey(router, t, "/transactions", "key-1", payload)
+	if first.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusCreated, first.Code, first.Body.String())
+	}
+
+	// 같은 키와 같은 본문 → 저장된 응답 반환 ( 재고는 한 번만 반영 )
+	second := postWithIdempotencyKey(router, t, "/transactions", "key-1", payload)
+	if second.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusCreated, second.Code, second.Body.String())
+	}
+	if second.Header().Get(middleware.IDEMPOTENCY_REPLAYED_HEADER) != "true" {
+		t.Errorf("Expected replayed header on second response")
+	}
+	if second.Body.String() != first.Body.String() {
+		t.Errorf("Expected replayed body %s, got %s", first.Body.String(), second.Body.String())
+	}
+
+	var count int64
+	db.Model(&models.Transaction{}).Where("inventory_id = ?", inventory.ID).Count(&count)
+	if count != 1 {
+		t.Errorf("Expected 1 transaction, got %d", count)
+	}
+	var updated models.Inventory
+	db.First(&updated, inventory.ID)
+	if updated.Quantity != 15 {
+		t.Errorf("Expected quantity 15, got %d", updated.Quantity)
+	}
+
+	// 같은 키에 다른 본문 → 422
+	changed := postWithIdempotencyKey(router, t, "/transactions", "key-1", dto.CreateTransactionDTO{InventoryID: inventory.ID, Quantity: 7, Type: "IN"})
+	if changed.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected status code %d, got %d", http.StatusUnprocessableEntity, changed.Code)
+	}
+
+	// 오류 응답도 저장된 그대로 반환
+	for i := 0; i < 2; i++ {
+		rr := postWithIdempotencyKey(router, t, "/transactions", "key-2", dto.CreateTransactionDTO{InventoryID: inventory.ID, Quantity: 100, Type: "OUT"})
+		if rr.Code != http.StatusConflict {
+			t.Errorf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+		}
+	}
+
+	// 헤더가 없으면 매번 새 요청으로 처리
+	for i := 0; i < 2; i++ {
+		rr := postWithIdempotencyKey(router, t, "/transactions", "", payload)
+		if rr.Code != http.StatusCreated {
+			t.Errorf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+		}
+	}
+	db.First(&updated, inventory.ID)
+	if updated.Quantity != 25 {
+		t.Errorf("Expected quantity 25, got %d", updated.Quantity)
+	}
+}
+
+func TestIdempotencyKeyReleasedAfterPanic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db := SetupTestDB()
+
+	user, err := CreateTestUser(db)
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	// 첫 요청은 처리 중 panic, 이후 요청은 정상 처리
+	calls := 0
+	router := gin.New()
+	router.Use(gin.Recovery(), func(c *gin.Context) {
+		c.Set("userID", user.ID)
+		c.Next()
+	}, middleware.IdempotencyMiddleware(repositories.NewIdempotencyKeyRepository(db)))
+	router.POST("/panic", func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			panic("handler failed")
+		}
+		c.JSON(http.StatusCreated, gin.H{"calls": calls})
+	})
+
+	first := postWithIdempotencyKey(router, t, "/panic", "panic-key", nil)
+	if first.Code != http.StatusInternalServerError {
+		t.Fatalf("Expected status code %d, got %d", http.StatusInternalServerError, first.Code)
+	}
+
+	// panic 으로 끝난 키는 처리 중으로 남지 않고 다시 사용 가능
+	second := postWithIdempotencyKey(router, t, "/panic", "panic-key", nil)
+	if second.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusCreated, second.Code, second.Body.String())
+	}
+
+	// 응답을 저장한 키는 처리 중 보관 기간보다 길게 보관
+	var record models.IdempotencyKey
+	if err := db.Where("key = ?", "panic-key").First(&record).Error; err != nil {
+		t.Fatalf("Failed to find idempotency key: %v", err)
+	}
+	if record.StatusCode != http.StatusCreated || record.ExpiresAt.Before(time.Now().Add(time.Hour)) {
+		t.Errorf("Expected saved response kept for a day, got status %d expires %v", record.StatusCode, record.ExpiresAt)
+	}
+}
+
+func TestIdempotencyKeyConditionalUpdates(t *testing.T) {
+	db := SetupTestDB()
+	repo := repositories.NewIdempotencyKeyRepository(db)
+
+	now := time.Now()
+	record, err := repo.Create(&models.IdempotencyKey{Key: "key-conditional", UserID: 1, RequestHash: "hash", ExpiresAt: now.Add(-time.Second)})
+	if err != nil {
+		t.Fatalf("Failed to create idempotency key: %v", err)
+	}
+
+	// 처리 중인 키는 보관 기간을 연장할 수 있고, 연장된 키는 만료 삭제 대상이 아님
+	if ok, err := repo.Refresh(record.ID, now.Add(time.Minute)); err != nil || !ok {
+		t.Fatalf("Expected pending key to be refreshed, got %v, %v", ok, err)
+	}
+	if ok, err := repo.DeleteExpired(record.ID, 0, now); err != nil || ok {
+		t.Fatalf("Expected refreshed key not to be deleted, got %v, %v", ok, err)
+	}
+
+	// 응답은 처리 중인 키에 한 번만 저장되고, 저장 후에는 연장/처리 중 상태 만료 삭제 불가
+	if ok, err := repo.SaveResponse(record.ID, http.StatusCreated, "application/json", []byte("{}"), now.Add(-time.Second)); err != nil || !ok {
+		t.Fatalf("Expected response to be saved, got %v, %v", ok, err)
+	}
+	if ok, err := repo.SaveResponse(record.ID, http.StatusOK, "application/json", []byte("{}"), now.Add(time.Hour)); err != nil || ok {
+		t.Fatalf("Expected second save to be rejected, got %v, %v", ok, err)
+	}
+	if ok, err := repo.Refresh(record.ID, now.Add(time.Minute)); err != nil || ok {
+		t.Fatalf("Expected saved key not to be refreshed, got %v, %v", ok, err)
+	}
+	if ok, err := repo.DeleteExpired(record.ID, 0, now); err != nil || ok {
+		t.Fatalf("Expected key saved after lookup not to be deleted, got %v, %v", ok, err)
+	}
+
+	// 조회한 상태 그대로 만료된 키는 삭제되고, 삭제된 키에는 응답을 저장하지 않음
+	if ok, err := repo.DeleteExpired(record.ID, http.StatusCreated, now); err != nil || !ok {
+		t.Fatalf("Expected expired key to be deleted, got %v, %v", ok, err)
+	}
+	if ok, err := repo.SaveResponse(record.ID, http.StatusCreated, "application/json", []byte("{}"), now.Add(time.Hour)); err != nil || ok {
+		t.Fatalf("Expected save on deleted key to be rejected, got %v, %v", ok, err)
+	}
+}
@@ -19,15 +19,54 @@ func SetupTestDB() *gorm.DB {
 	if err != nil {
 		log.Fatalf("Failed to open test database: %v", err)
 	}
+	migrateTestDB(db)
+
+	return db
+}
+
+// 동시 요청 테스트용 파일 DB ( 쓰기 트랜잭션은 시작 시 잠금을 획득해 순서대로 실행 )
+func SetupTestFileDB(path string) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(path+"?_txlock=immediate&_busy_timeout=10000"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		log.Fatalf("Failed to open test database: %v", err)
+	}
+	migrateTestDB(db)
+
+	return db
+}
+
+func migrateTestDB(db *gorm.DB) {
 	db.AutoMigrate(
 		&models.User{},
 		&models.Warehouse{},
 		&models.Product{},
+		&models.ProductUnit{},
+		&models.Category{},
+		&models.ProductAttribute{},
 		&models.Inventory{},
 		&models.Transaction{},
+		&models.Order{},
+		&models.OrderItem{},
+		&models.TransferOrder{},
+		&models.TransferOrderItem{},
+		&models.Reservation{},
+		&models.Lot{},
+		&models.TransactionLot{},
+		&models.SerialNumber{},
+		&models.BinLocation{},
+		&models.BinStock{},
+		&models.StockAlert{},
+		&models.Supplier{},
+		&models.PurchaseOrder{},
+		&models.PurchaseOrderLine{},
+		&models.Stocktake{},
+		&models.StocktakeLine{},
+		&models.CostLayer{},
+		&models.Barcode{},
+		&models.IdempotencyKey{},
 	)
-
-	return db
 }
 
 func CreateTestUser(db *gorm.DB) (*models.User, error) {
@@ -99,3 +138,56 @@ func CreateTestTransaction(db *gorm.DB, inventoryID uint, transactionType string
 
 	return &transaction, nil
 }
+
+func CreateTestOrder(db *gorm.DB, warehouseID uint, status string, items []models.OrderItem) (*models.Order, error) {
+	order := models.Order{
+		WarehouseID: warehouseID,
+		Customer:    "TestCustomer",
+		Status:      status,
+		OrderItems:  items,
+	}
+	if err := db.Create(&order).Error; err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func CreateTestTransferOrder(db *gorm.DB, sourceWarehouseID, destinationWarehouseID uint, items []models.TransferOrderItem) (*models.TransferOrder, error) {
+	transferOrder := models.TransferOrder{
+		SourceWarehouseID:      sourceWarehouseID,
+		DestinationWarehouseID: destinationWarehouseID,
+		Status:                 models.TRANSFER_STATUS_DRAFT,
+		Reference:              "TRANSFER-TEST",
+		Items:                  items,
+	}
+	if err := db.Create(&transferOrder).Error; err != nil {
+		return nil, err
+	}
+
+	return &transferOrder, nil
+}
+
+func CreateTestBinLocation(db *gorm.DB, warehouseID uint, zone, aisle string) (*models.BinLocation, error) {
+	binLocation := models.BinLocation{
+		WarehouseID: warehouseID,
+		Zone:        zone,
+		Aisle:       aisle,
+	}
+	if err := db.Create(&binLocation).Error; err != nil {
+		return nil, err
+	}
+
+	return &binLocation, nil
+}
+
+func CreateTestSupplier(db *gorm.DB, name string) (*models.Supplier, error) {
+	supplier := models.Supplier{
+		Name: name,
+	}
+	if err := db.Create(&supplier).Error; err != nil {
+		return nil, err
+	}
+
+	return &supplier, nil
+}
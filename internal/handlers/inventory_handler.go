@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"github.com/jhphon0730/StockFlow/internal/models"
 	"github.com/jhphon0730/StockFlow/internal/services"
 	"github.com/jhphon0730/StockFlow/pkg/dto"
 	"github.com/jhphon0730/StockFlow/pkg/utils"
@@ -10,13 +11,18 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 type InventoryHandler interface {
 	GetAllInventory(c *gin.Context)
 	GetInventory(c *gin.Context)
 	CreateInventory(c *gin.Context)
+	UpdateInventory(c *gin.Context)
 	DeleteInventory(c *gin.Context)
+	UpdateInventoryThresholds(c *gin.Context)
+	GetInventoryValuation(c *gin.Context)
+	GetStockReport(c *gin.Context)
 }
 
 type inventoryHandler struct {
@@ -32,8 +38,37 @@ func NewInventoryHandler(inventoryService services.InventoryService) InventoryHa
 func (i *inventoryHandler) GetAllInventory(c *gin.Context) {
 	ctx := c.Request.Context()
 	search_filter := utils.GetInventorySearchQuery(c)
+	page, err := utils.GetPageQuery(c, models.INVENTORY_SORT_FIELDS, 0)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	// as_of 지정 시 재고내역을 다시 반영한 시점 수량 반환
+	if as_of := c.Query("as_of"); as_of != "" {
+		asOf, err := utils.ParseAsOf(as_of)
+		if err != nil {
+			utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+			return
+		}
+
+		status, inventories, pagination, err := i.inventoryService.FindPageAsOf(search_filter, page, asOf)
+		if err != nil {
+			utils.JSONResponse(c, status, nil, err)
+			return
+		}
+
+		res_data := gin.H{
+			"as_of":       asOf,
+			"inventories": inventories,
+			"pagination":  pagination,
+		}
 
-	status, inventories, err := i.inventoryService.FindAll(ctx, search_filter)
+		utils.JSONResponse(c, status, res_data, nil)
+		return
+	}
+
+	status, inventories, pagination, err := i.inventoryService.FindAll(ctx, search_filter, page)
 	if err != nil {
 		utils.JSONResponse(c, status, nil, err)
 		return
@@ -41,6 +76,7 @@ func (i *inventoryHandler) GetAllInventory(c *gin.Context) {
 
 	res_data := gin.H{
 		"inventories": inventories,
+		"pagination":  pagination,
 	}
 
 	utils.JSONResponse(c, status, res_data, nil)
@@ -121,3 +157,130 @@ func (i *inventoryHandler) DeleteInventory(c *gin.Context) {
 
 	utils.JSONResponse(c, status, nil, nil)
 }
+
+func (i *inventoryHandler) UpdateInventoryThresholds(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var updateInventoryThresholdsDTO dto.UpdateInventoryThresholdsDTO
+	if err := c.ShouldBindJSON(&updateInventoryThresholdsDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := updateInventoryThresholdsDTO.CheckUpdateInventoryThresholdsDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, inventory, err := i.inventoryService.UpdateThresholds(
+		uint(id_int),
+		updateInventoryThresholdsDTO.MinQuantity,
+		updateInventoryThresholdsDTO.ReorderPoint,
+		updateInventoryThresholdsDTO.MaxQuantity,
+		ctx,
+	)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"inventory": inventory,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 재고 평가 보고서 조회 ( warehouse_id, product_id 로 범위 지정 )
+func (i *inventoryHandler) GetInventoryValuation(c *gin.Context) {
+	search_filter := utils.GetInventorySearchQuery(c)
+
+	status, report, err := i.inventoryService.GetValuation(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"valuation": report,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 창고별 시점 재고 보고서 ( as_of 미지정 시 현재 시각 )
+func (i *inventoryHandler) GetStockReport(c *gin.Context) {
+	search_filter := utils.GetInventorySearchQuery(c)
+
+	asOf := time.Now()
+	if as_of := c.Query("as_of"); as_of != "" {
+		parsed, err := utils.ParseAsOf(as_of)
+		if err != nil {
+			utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+			return
+		}
+		asOf = parsed
+	}
+
+	status, report, err := i.inventoryService.GetStockReport(search_filter, asOf)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"report": report,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 재고 수정 ( PATCH: 입력한 임계치만 변경, PUT: 입력하지 않은 임계치는 미설정 )
+func (i *inventoryHandler) UpdateInventory(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var updateInventoryDTO dto.UpdateInventoryDTO
+	if err := c.ShouldBindJSON(&updateInventoryDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := updateInventoryDTO.CheckUpdateInventoryDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, inventory, err := i.inventoryService.Update(uint(id_int), updateInventoryDTO.ToThresholds(c.Request.Method == http.MethodPut), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"inventory": inventory,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
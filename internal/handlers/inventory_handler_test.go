@@ -14,14 +14,17 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 )
 
 func setupInventory() (*gorm.DB, *gin.Engine, repositories.InventoryRepository, services.InventoryService, handlers.InventoryHandler) {
 	// Test DB 초기화
 	db := SetupTestDB()
 	inventoryRepo := repositories.NewInventoryRepository(db)
-	inventoryService := services.NewInventoryService(inventoryRepo)
+	transactionRepo := repositories.NewTransactionRepository(db)
+	inventoryService := services.NewInventoryService(inventoryRepo, transactionRepo)
 	inventoryHandler := handlers.NewInventoryHandler(inventoryService)
 
 	router := gin.Default()
@@ -201,3 +204,341 @@ func TestDeleteInventory(t *testing.T) {
 		t.Errorf("Expected inventory count to be 0 but got %d", inventoryCount)
 	}
 }
+
+func TestUpdateInventoryThresholds(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, inventoryHandler := setupInventory()
+	router.PUT("/inventories/:id/thresholds", inventoryHandler.UpdateInventoryThresholds)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+
+	for _, tc := range []struct {
+		payload  dto.UpdateInventoryThresholdsDTO
+		expected int
+	}{
+		{dto.UpdateInventoryThresholdsDTO{MinQuantity: 5, ReorderPoint: 3}, http.StatusBadRequest},
+		{dto.UpdateInventoryThresholdsDTO{MinQuantity: 2, ReorderPoint: 5, MaxQuantity: 20}, http.StatusOK},
+	} {
+		jsonPayload, err := json.Marshal(tc.payload)
+		if err != nil {
+			t.Fatalf("Failed to marshal JSON payload: %v", err)
+		}
+
+		req, err := http.NewRequest("PUT", "/inventories/1/thresholds", bytes.NewBuffer(jsonPayload))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d, got %d", tc.expected, rr.Code)
+		}
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+
+	if inventory.MinQuantity != 2 || inventory.ReorderPoint != 5 || inventory.MaxQuantity != 20 {
+		t.Errorf("Expected thresholds 2/5/20, got %d/%d/%d", inventory.MinQuantity, inventory.ReorderPoint, inventory.MaxQuantity)
+	}
+}
+
+func TestGetInventoryValuation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, inventoryHandler := setupInventory()
+	router.GET("/inventories/valuation", inventoryHandler.GetInventoryValuation)
+
+	CreateTestProduct(db, "TestProduct1", "TestSKU1")
+	CreateTestProduct(db, "TestProduct2", "TestSKU2")
+	CreateTestWarehouse(db, "TestWarehouse1", "TestLocation1")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	for _, inventory := range []models.Inventory{
+		{ProductID: 1, WarehouseID: 1, Quantity: 10, AverageCost: 2.5},
+		{ProductID: 2, WarehouseID: 1, Quantity: 4, AverageCost: 10},
+		{ProductID: 1, WarehouseID: 2, Quantity: 2, AverageCost: 3},
+	} {
+		db.Create(&inventory)
+	}
+
+	req, err := http.NewRequest("GET", "/inventories/valuation", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d but got %d", http.StatusOK, rr.Code)
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			Valuation *models.ValuationReport `json:"valuation"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	report := resp.Data.Valuation
+	if len(report.Items) != 3 || report.TotalValue != 71 {
+		t.Fatalf("Expected 3 items worth 71, got %d worth %.2f", len(report.Items), report.TotalValue)
+	}
+
+	for _, warehouse := range report.Warehouses {
+		if warehouse.ID == 1 && warehouse.TotalValue != 65 {
+			t.Errorf("Expected warehouse 1 to be worth 65, got %.2f", warehouse.TotalValue)
+		}
+	}
+
+	for _, product := range report.Products {
+		if product.ID == 1 && (product.Quantity != 12 || product.TotalValue != 31) {
+			t.Errorf("Expected product 1 to have 12 units worth 31, got %d worth %.2f", product.Quantity, product.TotalValue)
+		}
+	}
+}
+
+func TestGetInventoriesAsOf(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, inventoryHandler := setupInventory()
+	router.GET("/inventories", inventoryHandler.GetAllInventory)
+	router.GET("/inventories/stock-report", inventoryHandler.GetStockReport)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse1", "TestLocation1")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	CreateTestInventory(db, 1, 1, 25)
+	CreateTestInventory(db, 1, 2, 4)
+
+	day := func(month time.Month, d int) time.Time { return time.Date(2026, month, d, 12, 0, 0, 0, time.Local) }
+	for _, transaction := range []models.Transaction{
+		{InventoryID: 1, Type: "IN", Quantity: 10, Timestamp: day(time.March, 1)},
+		{InventoryID: 1, Type: "OUT", Quantity: 3, Timestamp: day(time.March, 15)},
+		{InventoryID: 2, Type: "IN", Quantity: 4, Timestamp: day(time.March, 31)},
+		{InventoryID: 1, Type: "ADJUST", Quantity: 20, QuantityBefore: 7, Timestamp: day(time.April, 2)},
+		// 조정보다 먼저 기록되었지만 나중에 반영된 출고 ( 반영 순서대로 계산 )
+		{InventoryID: 1, Type: "OUT", Quantity: 2, Timestamp: day(time.April, 2).Add(-time.Minute)},
+		{InventoryID: 1, Type: "IN", Quantity: 5, Timestamp: day(time.April, 5)},
+	} {
+		db.Create(&transaction)
+	}
+
+	for _, tc := range []struct {
+		asOf       string
+		expected   int
+		quantities map[uint]int
+	}{
+		{"2026-02-28", http.StatusOK, map[uint]int{}},
+		{"2026-03-31", http.StatusOK, map[uint]int{1: 7, 2: 4}},
+		{"2026-04-03", http.StatusOK, map[uint]int{1: 18, 2: 4}}, // ADJUST 는 수량 재설정
+		{day(time.April, 5).Format(time.RFC3339), http.StatusOK, map[uint]int{1: 23, 2: 4}},
+		{"last-quarter", http.StatusBadRequest, nil},
+	} {
+		req, err := http.NewRequest("GET", "/inventories?as_of="+url.QueryEscape(tc.asOf), nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d for %s, got %d", tc.expected, tc.asOf, rr.Code)
+		}
+		if tc.expected != http.StatusOK {
+			continue
+		}
+
+		var resp struct {
+			Response
+			Data struct {
+				Inventories []models.Inventory `json:"inventories"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+
+		quantities := make(map[uint]int)
+		for _, inventory := range resp.Data.Inventories {
+			quantities[inventory.ID] = inventory.Quantity
+		}
+		if len(quantities) != len(tc.quantities) {
+			t.Fatalf("Expected %v as of %s, got %v", tc.quantities, tc.asOf, quantities)
+		}
+		for id, quantity := range tc.quantities {
+			if quantities[id] != quantity {
+				t.Fatalf("Expected %v as of %s, got %v", tc.quantities, tc.asOf, quantities)
+			}
+		}
+	}
+
+	req, err := http.NewRequest("GET", "/inventories/stock-report?as_of=2026-03-31", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d but got %d", http.StatusOK, rr.Code)
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			Report *models.StockReport `json:"report"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	report := resp.Data.Report
+	if len(report.Warehouses) != 2 || report.TotalQuantity != 11 {
+		t.Fatalf("Expected 2 warehouses with total 11, got %d with total %d", len(report.Warehouses), report.TotalQuantity)
+	}
+	if report.Warehouses[0].WarehouseName != "TestWarehouse1" || report.Warehouses[0].TotalQuantity != 7 {
+		t.Errorf("Expected TestWarehouse1 to hold 7, got %+v", report.Warehouses[0])
+	}
+}
+
+func TestGetInventoriesAsOfDeleted(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, inventoryHandler := setupInventory()
+	router.GET("/inventories", inventoryHandler.GetAllInventory)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse1", "TestLocation1")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	CreateTestInventory(db, 1, 1, 8)
+	CreateTestInventory(db, 1, 2, 0)
+
+	day := func(month time.Month, d int) time.Time { return time.Date(2026, month, d, 12, 0, 0, 0, time.Local) }
+	canceled := models.Transaction{InventoryID: 1, Type: "IN", Quantity: 2, Timestamp: day(time.March, 5)}
+	for _, transaction := range []*models.Transaction{
+		{InventoryID: 1, Type: "IN", Quantity: 10, Timestamp: day(time.March, 1)},
+		&canceled,
+		{InventoryID: 2, Type: "IN", Quantity: 6, Timestamp: day(time.March, 1)},
+	} {
+		db.Create(transaction)
+	}
+
+	// 재고내역 2 는 3월 10일, 재고 2 는 3월 20일에 삭제
+	db.Model(&canceled).Update("deleted_at", day(time.March, 10))
+	db.Model(&models.Inventory{}).Where("id = ?", 2).Update("deleted_at", day(time.March, 20))
+
+	for _, tc := range []struct {
+		asOf       string
+		quantities map[uint]int
+	}{
+		{"2026-03-07", map[uint]int{1: 12, 2: 6}},
+		{"2026-03-15", map[uint]int{1: 10, 2: 6}},
+		{"2026-03-31", map[uint]int{1: 10}},
+	} {
+		rr := sendJSON(router, t, "GET", "/inventories?as_of="+tc.asOf, nil)
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected status code %d for %s, got %d", http.StatusOK, tc.asOf, rr.Code)
+		}
+
+		var resp struct {
+			Response
+			Data struct {
+				Inventories []models.Inventory `json:"inventories"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+
+		quantities := make(map[uint]int)
+		for _, inventory := range resp.Data.Inventories {
+			quantities[inventory.ID] = inventory.Quantity
+		}
+		if len(quantities) != len(tc.quantities) {
+			t.Fatalf("Expected %v as of %s, got %v", tc.quantities, tc.asOf, quantities)
+		}
+		for id, quantity := range tc.quantities {
+			if quantities[id] != quantity {
+				t.Fatalf("Expected %v as of %s, got %v", tc.quantities, tc.asOf, quantities)
+			}
+		}
+	}
+}
+
+func TestUpdateInventory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, inventoryHandler := setupInventory()
+	router.PUT("/inventories/:id", inventoryHandler.UpdateInventory)
+	router.PATCH("/inventories/:id", inventoryHandler.UpdateInventory)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+
+	for _, tc := range []struct {
+		method    string
+		payload   map[string]interface{}
+		expected  int
+		threshold [3]int
+	}{
+		{"PATCH", map[string]interface{}{"min_quantity": 2, "max_quantity": 20}, http.StatusOK, [3]int{2, 0, 20}},
+		{"PATCH", map[string]interface{}{"reorder_point": 5}, http.StatusOK, [3]int{2, 5, 20}},
+		// 기존 값과 합쳐 검증 ( 최소 수량 > 재주문점 )
+		{"PATCH", map[string]interface{}{"min_quantity": 8}, http.StatusBadRequest, [3]int{2, 5, 20}},
+		{"PATCH", map[string]interface{}{"quantity": 100}, http.StatusBadRequest, [3]int{2, 5, 20}},
+		{"PATCH", map[string]interface{}{"warehouse_id": 2}, http.StatusBadRequest, [3]int{2, 5, 20}},
+		{"PATCH", map[string]interface{}{"max_quantity": 4}, http.StatusBadRequest, [3]int{2, 5, 20}},
+		{"PATCH", map[string]interface{}{}, http.StatusOK, [3]int{2, 5, 20}},
+		// PUT 은 입력하지 않은 임계치를 미설정(0)으로 변경
+		{"PUT", map[string]interface{}{"reorder_point": 3}, http.StatusOK, [3]int{0, 3, 0}},
+	} {
+		rr := sendJSON(router, t, tc.method, "/inventories/1", tc.payload)
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d for %s %v, got %d: %s", tc.expected, tc.method, tc.payload, rr.Code, rr.Body.String())
+		}
+
+		inventory, err := inventoryRepo.FindByID(1)
+		if err != nil {
+			t.Fatalf("Failed to find inventory: %v", err)
+		}
+		got := [3]int{inventory.MinQuantity, inventory.ReorderPoint, inventory.MaxQuantity}
+		if got != tc.threshold || inventory.Quantity != 10 {
+			t.Errorf("Expected thresholds %v with quantity 10, got %v with quantity %d", tc.threshold, got, inventory.Quantity)
+		}
+	}
+	// 존재하지 않는 재고
+	for _, method := range []string{"PUT", "PATCH"} {
+		rr := sendJSON(router, t, method, "/inventories/999", map[string]interface{}{"reorder_point": 3})
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("Expected status code %d for %s on missing inventory, got %d: %s", http.StatusNotFound, method, rr.Code, rr.Body.String())
+		}
+	}
+}
+
+func TestFindInventoryWarehouseIDs(t *testing.T) {
+	db, _, inventoryRepo, _, _ := setupInventory()
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	CreateTestInventory(db, 1, 1, 10)
+	CreateTestInventory(db, 1, 2, 5)
+
+	// 존재하지 않는 재고는 결과에서 제외
+	warehouseIDs, err := inventoryRepo.FindWarehouseIDs([]uint{1, 2, 2, 999})
+	if err != nil {
+		t.Fatalf("Failed to find warehouse ids: %v", err)
+	}
+	if len(warehouseIDs) != 2 || warehouseIDs[1] != 1 || warehouseIDs[2] != 2 {
+		t.Errorf("Expected map[1:1 2:2], got %v", warehouseIDs)
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+	"github.com/jhphon0730/StockFlow/pkg/label"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type LabelHandler interface {
+	GetProductLabel(c *gin.Context)
+	GetInventoryLabel(c *gin.Context)
+	GetBinLocationLabel(c *gin.Context)
+	CreateLabelSheet(c *gin.Context)
+}
+
+type labelHandler struct {
+	labelService services.LabelService
+}
+
+func NewLabelHandler(labelService services.LabelService) LabelHandler {
+	return &labelHandler{
+		labelService: labelService,
+	}
+}
+
+// 라벨 조회 공통 처리 ( ?type=CODE128|QR&format=png|svg )
+func (h *labelHandler) renderLabel(c *gin.Context, find func(id uint) (int, *label.Label, error)) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, l, err := find(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	data, contentType, err := label.Render(*l, c.Query("type"), c.Query("format"))
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, data)
+}
+
+func (h *labelHandler) GetProductLabel(c *gin.Context) {
+	h.renderLabel(c, h.labelService.ProductLabel)
+}
+
+func (h *labelHandler) GetInventoryLabel(c *gin.Context) {
+	h.renderLabel(c, h.labelService.InventoryLabel)
+}
+
+func (h *labelHandler) GetBinLocationLabel(c *gin.Context) {
+	h.renderLabel(c, h.labelService.BinLocationLabel)
+}
+
+// 창고 또는 제품 목록의 라벨을 A4 PDF 로 출력
+func (h *labelHandler) CreateLabelSheet(c *gin.Context) {
+	var createLabelSheetDTO dto.CreateLabelSheetDTO
+	if err := c.ShouldBindJSON(&createLabelSheetDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := createLabelSheetDTO.CheckCreateLabelSheetDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, labels, err := h.labelService.SheetLabels(createLabelSheetDTO.WarehouseID, createLabelSheetDTO.ProductIDs, createLabelSheetDTO.Bins)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	data, err := label.RenderSheet(labels, createLabelSheetDTO.Type)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="labels.pdf"`)
+	c.Data(http.StatusOK, "application/pdf", data)
+}
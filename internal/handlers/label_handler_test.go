@@ -0,0 +1,150 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"bytes"
+	"fmt"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func setupLabel() (*gorm.DB, *gin.Engine) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	productRepo := repositories.NewProductRepository(db)
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	binLocationRepo := repositories.NewBinLocationRepository(db)
+	warehouseRepo := repositories.NewWarehouseRepository(db)
+	labelService := services.NewLabelService(productRepo, inventoryRepo, binLocationRepo, warehouseRepo)
+	labelHandler := handlers.NewLabelHandler(labelService)
+
+	router := gin.Default()
+	router.GET("/labels/products/:id", labelHandler.GetProductLabel)
+	router.GET("/labels/inventories/:id", labelHandler.GetInventoryLabel)
+	router.GET("/labels/bins/:id", labelHandler.GetBinLocationLabel)
+	router.POST("/labels/sheet", labelHandler.CreateLabelSheet)
+	return db, router
+}
+
+func TestGetLabelImages(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router := setupLabel()
+
+	product, err := CreateTestProduct(db, "Label Product", "LB-001")
+	if err != nil {
+		t.Fatalf("Failed to create test product: %v", err)
+	}
+	warehouse, err := CreateTestWarehouse(db, "Label Warehouse", "Location")
+	if err != nil {
+		t.Fatalf("Failed to create test warehouse: %v", err)
+	}
+	inventory, err := CreateTestInventory(db, product.ID, warehouse.ID, 10)
+	if err != nil {
+		t.Fatalf("Failed to create test inventory: %v", err)
+	}
+	binLocation, err := CreateTestBinLocation(db, warehouse.ID, "A", "01")
+	if err != nil {
+		t.Fatalf("Failed to create test bin location: %v", err)
+	}
+
+	for _, tc := range []struct {
+		path        string
+		expected    int
+		contentType string
+		contains    string
+	}{
+		{fmt.Sprintf("/labels/products/%d", product.ID), http.StatusOK, "image/png", ""},
+		{fmt.Sprintf("/labels/products/%d?type=QR", product.ID), http.StatusOK, "image/png", ""},
+		{fmt.Sprintf("/labels/products/%d?format=svg", product.ID), http.StatusOK, "image/svg+xml", "LB-001"},
+		{fmt.Sprintf("/labels/inventories/%d?type=QR&format=svg", inventory.ID), http.StatusOK, "image/svg+xml", "Label Warehouse"},
+		{fmt.Sprintf("/labels/bins/%d?format=svg", binLocation.ID), http.StatusOK, "image/svg+xml", "A-01"},
+		{fmt.Sprintf("/labels/products/%d?type=EAN13", product.ID), http.StatusBadRequest, "", ""},
+		{"/labels/products/999", http.StatusNotFound, "", ""},
+	} {
+		req, err := http.NewRequest("GET", tc.path, nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d for %s, got %d", tc.expected, tc.path, rr.Code)
+		}
+		if tc.expected != http.StatusOK {
+			continue
+		}
+
+		if got := rr.Header().Get("Content-Type"); got != tc.contentType {
+			t.Fatalf("Expected content type %s for %s, got %s", tc.contentType, tc.path, got)
+		}
+		if tc.contentType == "image/png" {
+			if _, err := png.Decode(bytes.NewReader(rr.Body.Bytes())); err != nil {
+				t.Fatalf("Failed to decode PNG for %s: %v", tc.path, err)
+			}
+		}
+		if !strings.Contains(rr.Body.String(), tc.contains) {
+			t.Fatalf("Expected %s to contain %q", tc.path, tc.contains)
+		}
+	}
+}
+
+func TestCreateLabelSheet(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router := setupLabel()
+
+	warehouse, err := CreateTestWarehouse(db, "Sheet Warehouse", "Location")
+	if err != nil {
+		t.Fatalf("Failed to create test warehouse: %v", err)
+	}
+
+	// 용지당 24개 → 30개 제품은 2페이지
+	var productIDs []uint
+	for i := 0; i < 30; i++ {
+		product, err := CreateTestProduct(db, fmt.Sprintf("Sheet Product %d", i), fmt.Sprintf("SH-%03d", i))
+		if err != nil {
+			t.Fatalf("Failed to create test product: %v", err)
+		}
+		if _, err := CreateTestInventory(db, product.ID, warehouse.ID, i); err != nil {
+			t.Fatalf("Failed to create test inventory: %v", err)
+		}
+		productIDs = append(productIDs, product.ID)
+	}
+
+	for _, tc := range []struct {
+		payload  dto.CreateLabelSheetDTO
+		expected int
+		pages    int
+	}{
+		{dto.CreateLabelSheetDTO{ProductIDs: productIDs}, http.StatusOK, 2},
+		{dto.CreateLabelSheetDTO{WarehouseID: &warehouse.ID, ProductIDs: productIDs[:5], Type: "QR"}, http.StatusOK, 1},
+		{dto.CreateLabelSheetDTO{}, http.StatusBadRequest, 0},
+		{dto.CreateLabelSheetDTO{ProductIDs: []uint{999}}, http.StatusBadRequest, 0},
+	} {
+		rr := postTransferOrder(router, t, "/labels/sheet", tc.payload)
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d, got %d", tc.expected, rr.Code)
+		}
+		if tc.expected != http.StatusOK {
+			continue
+		}
+
+		body := rr.Body.String()
+		if !strings.HasPrefix(body, "%PDF-") || !strings.Contains(body, "%%EOF") {
+			t.Fatalf("Expected PDF document, got %.20q", body)
+		}
+		if !strings.Contains(body, fmt.Sprintf("/Count %d", tc.pages)) {
+			t.Fatalf("Expected %d pages", tc.pages)
+		}
+	}
+}
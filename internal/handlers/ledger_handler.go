@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LedgerHandler interface {
+	CheckLedger(c *gin.Context)
+	RepairLedger(c *gin.Context)
+}
+
+type ledgerHandler struct {
+	ledgerService services.LedgerService
+}
+
+func NewLedgerHandler(ledgerService services.LedgerService) LedgerHandler {
+	return &ledgerHandler{
+		ledgerService: ledgerService,
+	}
+}
+
+// 재고 수량과 재고내역 재계산 수량 비교 ( warehouse_id, product_id 로 범위 지정 )
+func (h *ledgerHandler) CheckLedger(c *gin.Context) {
+	search_filter := utils.GetInventorySearchQuery(c)
+
+	status, report, err := h.ledgerService.Check(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"report": report,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 불일치 재고를 재고내역 수량으로 조정(ADJUST)
+func (h *ledgerHandler) RepairLedger(c *gin.Context) {
+	ctx := c.Request.Context()
+	search_filter := utils.GetInventorySearchQuery(c)
+
+	status, report, err := h.ledgerService.Repair(search_filter, ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"report": report,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
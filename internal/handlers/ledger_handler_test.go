@@ -0,0 +1,151 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupLedger() (*gorm.DB, *gin.Engine) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	transactionRepo := repositories.NewTransactionRepository(db)
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	lotRepo := repositories.NewLotRepository(db)
+	serialNumberRepo := repositories.NewSerialNumberRepository(db)
+	binLocationRepo := repositories.NewBinLocationRepository(db)
+	stockAlertRepo := repositories.NewStockAlertRepository(db)
+	costLayerRepo := repositories.NewCostLayerRepository(db)
+	transactionService := services.NewTransactionService(transactionRepo, inventoryRepo, repositories.NewWarehouseRepository(db), lotRepo, serialNumberRepo, binLocationRepo, stockAlertRepo, costLayerRepo)
+	ledgerService := services.NewLedgerService(inventoryRepo, transactionRepo, transactionService)
+	ledgerHandler := handlers.NewLedgerHandler(ledgerService)
+
+	router := gin.Default()
+	router.GET("/admin/ledger/check", ledgerHandler.CheckLedger)
+	router.POST("/admin/ledger/repair", ledgerHandler.RepairLedger)
+	return db, router
+}
+
+func requestLedger(router *gin.Engine, t *testing.T, method, path string) *models.LedgerCheckReport {
+	req, err := http.NewRequest(method, path, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			Report *models.LedgerCheckReport `json:"report"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	return resp.Data.Report
+}
+
+func TestLedgerCheckAndRepair(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router := setupLedger()
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	CreateTestWarehouse(db, "TestWarehouse3", "TestLocation3")
+	CreateTestInventory(db, 1, 1, 12) // 재고내역 10 → 차이 2
+	CreateTestInventory(db, 1, 2, 5)  // 일치
+	CreateTestInventory(db, 1, 3, 4)  // 재고내역 -2 → 음수 재고 금지 창고라 보정 실패
+	CreateTestTransaction(db, 1, "IN", 10)
+	CreateTestTransaction(db, 2, "IN", 5)
+	CreateTestTransaction(db, 3, "OUT", 2)
+
+	report := requestLedger(router, t, "GET", "/admin/ledger/check")
+	if report.InventoryCount != 3 || len(report.Mismatches) != 2 {
+		t.Fatalf("Expected 2 mismatches in 3 inventories, got %d in %d", len(report.Mismatches), report.InventoryCount)
+	}
+	if mismatch := report.Mismatches[0]; mismatch.InventoryID != 1 || mismatch.LedgerQuantity != 10 || mismatch.Difference != 2 {
+		t.Fatalf("Expected inventory 1 to differ by 2, got %+v", mismatch)
+	}
+
+	report = requestLedger(router, t, "GET", "/admin/ledger/check?warehouse_id=2")
+	if report.InventoryCount != 1 || len(report.Mismatches) != 0 {
+		t.Fatalf("Expected warehouse 2 to be consistent, got %+v", report)
+	}
+
+	report = requestLedger(router, t, "POST", "/admin/ledger/repair")
+	if !report.Repaired || report.Mismatches[0].RepairTransactionID == nil || report.Mismatches[1].RepairError == "" {
+		t.Fatalf("Expected inventory 1 repaired and inventory 3 to fail, got %+v", report.Mismatches)
+	}
+
+	var repair models.Transaction
+	if err := db.First(&repair, *report.Mismatches[0].RepairTransactionID).Error; err != nil {
+		t.Fatalf("Failed to find repair transaction: %v", err)
+	}
+	if repair.Type != "ADJUST" || repair.Quantity != 10 || repair.Reference != report.Reference || repair.Note == "" {
+		t.Errorf("Expected documented ADJUST to 10, got %+v", repair)
+	}
+
+	report = requestLedger(router, t, "GET", "/admin/ledger/check")
+	if len(report.Mismatches) != 1 || report.Mismatches[0].InventoryID != 3 {
+		t.Fatalf("Expected only inventory 3 to remain mismatched, got %+v", report.Mismatches)
+	}
+}
+
+func TestLedgerRepairLotTracked(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router := setupLedger()
+
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Model(product).Update("lot_tracked", true)
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	CreateTestInventory(db, 1, 1, 12) // 재고내역 10 → 로트에서 2 차감
+	CreateTestInventory(db, 1, 2, 4)  // 재고내역 6 → 기본 로트에 2 입고
+	CreateTestTransaction(db, 1, "IN", 10)
+	CreateTestTransaction(db, 2, "IN", 6)
+	db.Create(&models.Lot{InventoryID: 1, LotNumber: "LOT-A", Quantity: 12})
+	db.Create(&models.Lot{InventoryID: 2, LotNumber: "LOT-B", Quantity: 4})
+
+	report := requestLedger(router, t, "POST", "/admin/ledger/repair")
+	if len(report.Mismatches) != 2 {
+		t.Fatalf("Expected 2 mismatches, got %+v", report.Mismatches)
+	}
+	for _, mismatch := range report.Mismatches {
+		if mismatch.RepairTransactionID == nil || mismatch.RepairError != "" {
+			t.Errorf("Expected inventory %d to be repaired, got %+v", mismatch.InventoryID, mismatch)
+		}
+	}
+
+	var lots []models.Lot
+	db.Order("inventory_id, lot_number").Find(&lots)
+	got := make(map[string]int)
+	for _, lot := range lots {
+		got[fmt.Sprintf("%d/%s", lot.InventoryID, lot.LotNumber)] = lot.Quantity
+	}
+	expected := map[string]int{"1/LOT-A": 10, "2/LOT-B": 4, "2/" + models.DEFAULT_LOT_NUMBER: 2}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected lots %v, got %v", expected, got)
+	}
+	for key, quantity := range expected {
+		if got[key] != quantity {
+			t.Errorf("Expected lot %s to be %d, got %d", key, quantity, got[key])
+		}
+	}
+}
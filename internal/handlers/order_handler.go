@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type OrderHandler interface {
+	GetAllOrders(c *gin.Context)
+	GetOrder(c *gin.Context)
+	CreateOrder(c *gin.Context)
+	UpdateOrderStatus(c *gin.Context)
+	DeleteOrder(c *gin.Context)
+}
+
+type orderHandler struct {
+	orderService services.OrderService
+}
+
+func NewOrderHandler(orderService services.OrderService) OrderHandler {
+	return &orderHandler{
+		orderService: orderService,
+	}
+}
+
+func (o *orderHandler) GetAllOrders(c *gin.Context) {
+	search_filter := utils.GetOrderSearchQuery(c)
+
+	status, orders, err := o.orderService.FindAll(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"orders": orders,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (o *orderHandler) GetOrder(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, order, err := o.orderService.FindByID(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"order": order,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (o *orderHandler) CreateOrder(c *gin.Context) {
+	var createOrderDTO dto.CreateOrderDTO
+	if err := c.ShouldBindJSON(&createOrderDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := createOrderDTO.CheckCreateOrderDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, order, err := o.orderService.Create(createOrderDTO.ToModel(c.GetUint("userID")))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"order": order,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (o *orderHandler) UpdateOrderStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var updateOrderStatusDTO dto.UpdateOrderStatusDTO
+	if err := c.ShouldBindJSON(&updateOrderStatusDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := updateOrderStatusDTO.CheckUpdateOrderStatusDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, order, err := o.orderService.UpdateStatus(uint(id_int), updateOrderStatusDTO.Status, updateOrderStatusDTO.ToSerials(), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"order": order,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (o *orderHandler) DeleteOrder(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, err := o.orderService.Delete(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	utils.JSONResponse(c, status, nil, nil)
+}
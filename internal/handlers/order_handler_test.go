@@ -0,0 +1,457 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func setupOrder() (*gorm.DB, *gin.Engine, repositories.InventoryRepository, handlers.OrderHandler) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	transactionRepo := repositories.NewTransactionRepository(db)
+	orderRepo := repositories.NewOrderRepository(db)
+	lotRepo := repositories.NewLotRepository(db)
+	serialNumberRepo := repositories.NewSerialNumberRepository(db)
+	binLocationRepo := repositories.NewBinLocationRepository(db)
+	stockAlertRepo := repositories.NewStockAlertRepository(db)
+	costLayerRepo := repositories.NewCostLayerRepository(db)
+	reservationRepo := repositories.NewReservationRepository(db)
+	transactionService := services.NewTransactionService(transactionRepo, inventoryRepo, repositories.NewWarehouseRepository(db), lotRepo, serialNumberRepo, binLocationRepo, stockAlertRepo, costLayerRepo)
+	orderService := services.NewOrderService(orderRepo, inventoryRepo, transactionRepo, reservationRepo, transactionService)
+	orderHandler := handlers.NewOrderHandler(orderService)
+
+	router := gin.Default()
+	return db, router, inventoryRepo, orderHandler
+}
+
+func updateOrderStatus(router *gin.Engine, t *testing.T, path string, status string) *httptest.ResponseRecorder {
+	jsonPayload, err := json.Marshal(dto.UpdateOrderStatusDTO{Status: status})
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	req, err := http.NewRequest("PUT", path, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestCreateOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, orderHandler := setupOrder()
+	router.POST("/orders", orderHandler.CreateOrder)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+
+	payload := dto.CreateOrderDTO{
+		WarehouseID: 1,
+		Customer:    "TestCustomer",
+		Items: []dto.CreateOrderItemDTO{
+			{ProductID: 1, Quantity: 3},
+		},
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	req, err := http.NewRequest("POST", "/orders", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			Order *models.Order `json:"order"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if resp.Data.Order == nil || resp.Data.Order.ID == 0 {
+		t.Fatalf("Expected order to be returned, got nil")
+	}
+
+	if resp.Data.Order.Status != models.ORDER_STATUS_DRAFT {
+		t.Errorf("Expected status %s, got %s", models.ORDER_STATUS_DRAFT, resp.Data.Order.Status)
+	}
+
+	if len(resp.Data.Order.OrderItems) != 1 {
+		t.Errorf("Expected 1 order item, got %d", len(resp.Data.Order.OrderItems))
+	}
+}
+
+func TestCreateOrderWithUnknownProduct(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, orderHandler := setupOrder()
+	router.POST("/orders", orderHandler.CreateOrder)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+
+	payload := dto.CreateOrderDTO{
+		WarehouseID: 1,
+		Customer:    "TestCustomer",
+		Items: []dto.CreateOrderItemDTO{
+			{ProductID: 1, Quantity: 3},
+		},
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	req, err := http.NewRequest("POST", "/orders", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+}
+
+func TestShipOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, orderHandler := setupOrder()
+	router.PUT("/orders/:id/status", orderHandler.UpdateOrderStatus)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestProduct(db, "TestProduct2", "TestSKU2")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+	CreateTestInventory(db, 2, 1, 20)
+	CreateTestOrder(db, 1, models.ORDER_STATUS_DRAFT, []models.OrderItem{
+		{ProductID: 1, Quantity: 3},
+		{ProductID: 2, Quantity: 5},
+	})
+
+	for _, status := range []string{models.ORDER_STATUS_APPROVED, models.ORDER_STATUS_PICKING, models.ORDER_STATUS_SHIPPED} {
+		rr := updateOrderStatus(router, t, "/orders/1/status", status)
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected status code %d for %s, got %d", http.StatusOK, status, rr.Code)
+		}
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+	if inventory.Quantity != 7 {
+		t.Errorf("Expected inventory quantity to be 7, got %d", inventory.Quantity)
+	}
+
+	inventory, err = inventoryRepo.FindByID(2)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+	if inventory.Quantity != 15 {
+		t.Errorf("Expected inventory quantity to be 15, got %d", inventory.Quantity)
+	}
+
+	var transactionCount int64
+	if err := db.Model(&models.Transaction{}).Where("type = ? AND reference = ?", "OUT", "ORDER-1").Count(&transactionCount).Error; err != nil {
+		t.Fatalf("Failed to count transactions: %v", err)
+	}
+	if transactionCount != 2 {
+		t.Errorf("Expected 2 OUT transactions, got %d", transactionCount)
+	}
+
+	// 이미 출고된 주문은 다시 출고되지 않음
+	rr := updateOrderStatus(router, t, "/orders/1/status", models.ORDER_STATUS_SHIPPED)
+	if rr.Code != http.StatusConflict {
+		t.Errorf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+	db.Model(&models.Transaction{}).Where("type = ? AND reference = ?", "OUT", "ORDER-1").Count(&transactionCount)
+	if transactionCount != 2 {
+		t.Errorf("Expected 2 OUT transactions after second ship, got %d", transactionCount)
+	}
+
+	rr = updateOrderStatus(router, t, "/orders/99/status", models.ORDER_STATUS_APPROVED)
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, rr.Code)
+	}
+}
+
+func TestShipReservedOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, orderHandler := setupOrder()
+	router.PUT("/orders/:id/status", orderHandler.UpdateOrderStatus)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	inventory, _ := CreateTestInventory(db, 1, 1, 10)
+	for _, quantity := range []int{6, 3, 2} {
+		CreateTestOrder(db, 1, models.ORDER_STATUS_PICKING, []models.OrderItem{{ProductID: 1, Quantity: quantity}})
+	}
+
+	// 주문 1, 3 이 각각 6 개, 2 개 예약 ( 가용 수량 2 )
+	expiresAt := time.Now().Add(time.Hour)
+	db.Create(&models.Reservation{InventoryID: inventory.ID, Quantity: 6, OwnerReference: "ORDER-1", Status: models.RESERVATION_STATUS_ACTIVE, ExpiresAt: expiresAt})
+	db.Create(&models.Reservation{InventoryID: inventory.ID, Quantity: 2, OwnerReference: "ORDER-3", Status: models.RESERVATION_STATUS_ACTIVE, ExpiresAt: expiresAt})
+	db.Model(inventory).Update("reserved_quantity", 8)
+
+	// 예약 없는 주문은 예약된 수량을 출고할 수 없음
+	rr := updateOrderStatus(router, t, "/orders/2/status", models.ORDER_STATUS_SHIPPED)
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+
+	// 예약한 주문은 예약을 소진하며 출고, 취소한 주문은 예약 해제
+	rr = updateOrderStatus(router, t, "/orders/1/status", models.ORDER_STATUS_SHIPPED)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+	rr = updateOrderStatus(router, t, "/orders/3/status", models.ORDER_STATUS_CANCELLED)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	found, err := inventoryRepo.FindByID(inventory.ID)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+	if found.Quantity != 4 || found.ReservedQuantity != 0 {
+		t.Errorf("Expected quantity 4 with nothing reserved, got %d reserved %d", found.Quantity, found.ReservedQuantity)
+	}
+
+	var reservations []models.Reservation
+	db.Order("id").Find(&reservations)
+	if len(reservations) != 2 || reservations[0].Status != models.RESERVATION_STATUS_CONSUMED || reservations[1].Status != models.RESERVATION_STATUS_RELEASED {
+		t.Errorf("Expected consumed and released reservations, got %+v", reservations)
+	}
+
+	// 예약이 해제된 뒤에는 출고 가능
+	rr = updateOrderStatus(router, t, "/orders/2/status", models.ORDER_STATUS_SHIPPED)
+	if rr.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+}
+
+func TestShipSerializedOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, orderHandler := setupOrder()
+	router.PUT("/orders/:id/status", orderHandler.UpdateOrderStatus)
+
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Model(product).Update("serialized", true)
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	inventory, _ := CreateTestInventory(db, 1, 1, 2)
+	for _, serial := range []string{"SN-1", "SN-2"} {
+		db.Create(&models.SerialNumber{ProductID: product.ID, InventoryID: inventory.ID, Serial: serial, Status: models.SERIAL_STATUS_IN_STOCK})
+	}
+	order, _ := CreateTestOrder(db, 1, models.ORDER_STATUS_PICKING, []models.OrderItem{
+		{ProductID: 1, Quantity: 2},
+	})
+	itemID := order.OrderItems[0].ID
+
+	// 일련번호 없이 출고 불가
+	rr := updateOrderStatus(router, t, "/orders/1/status", models.ORDER_STATUS_SHIPPED)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+
+	// 주문에 없는 항목
+	rr = sendJSON(router, t, "PUT", "/orders/1/status", dto.UpdateOrderStatusDTO{
+		Status: models.ORDER_STATUS_SHIPPED,
+		Items:  []dto.ShipOrderItemDTO{{ItemID: itemID + 1, Serials: []string{"SN-1", "SN-2"}}},
+	})
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+
+	rr = sendJSON(router, t, "PUT", "/orders/1/status", dto.UpdateOrderStatusDTO{
+		Status: models.ORDER_STATUS_SHIPPED,
+		Items:  []dto.ShipOrderItemDTO{{ItemID: itemID, Serials: []string{"SN-1", "SN-2"}}},
+	})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var shipped int64
+	db.Model(&models.SerialNumber{}).Where("status = ?", models.SERIAL_STATUS_OUT).Count(&shipped)
+	if shipped != 2 {
+		t.Errorf("Expected 2 shipped serial numbers, got %d", shipped)
+	}
+}
+
+func TestInvalidOrderStatusTransition(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, orderHandler := setupOrder()
+	router.PUT("/orders/:id/status", orderHandler.UpdateOrderStatus)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+	CreateTestOrder(db, 1, models.ORDER_STATUS_DRAFT, []models.OrderItem{
+		{ProductID: 1, Quantity: 3},
+	})
+
+	rr := updateOrderStatus(router, t, "/orders/1/status", models.ORDER_STATUS_SHIPPED)
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+
+	var transactionCount int64
+	if err := db.Model(&models.Transaction{}).Count(&transactionCount).Error; err != nil {
+		t.Fatalf("Failed to count transactions: %v", err)
+	}
+	if transactionCount != 0 {
+		t.Errorf("Expected no transactions, got %d", transactionCount)
+	}
+}
+
+func TestDeleteOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, orderHandler := setupOrder()
+	router.DELETE("/orders/:id", orderHandler.DeleteOrder)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+	CreateTestOrder(db, 1, models.ORDER_STATUS_APPROVED, []models.OrderItem{
+		{ProductID: 1, Quantity: 3},
+	})
+	CreateTestOrder(db, 1, models.ORDER_STATUS_DRAFT, []models.OrderItem{
+		{ProductID: 1, Quantity: 3},
+	})
+
+	req, err := http.NewRequest("DELETE", "/orders/1", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+
+	req, err = http.NewRequest("DELETE", "/orders/2", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+}
+
+func TestConcurrentOrderShipAndTransactions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db := SetupTestFileDB(filepath.Join(t.TempDir(), "order_concurrency.db"))
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	transactionRepo := repositories.NewTransactionRepository(db)
+	transactionService := services.NewTransactionService(
+		transactionRepo,
+		inventoryRepo,
+		repositories.NewWarehouseRepository(db),
+		repositories.NewLotRepository(db),
+		repositories.NewSerialNumberRepository(db),
+		repositories.NewBinLocationRepository(db),
+		repositories.NewStockAlertRepository(db),
+		repositories.NewCostLayerRepository(db),
+	)
+	orderService := services.NewOrderService(repositories.NewOrderRepository(db), inventoryRepo, transactionRepo, repositories.NewReservationRepository(db), transactionService)
+
+	router := gin.New()
+	router.PUT("/orders/:id/status", handlers.NewOrderHandler(orderService).UpdateOrderStatus)
+	router.POST("/transactions", handlers.NewTransactionHandler(transactionService).CreateTransaction)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 100)
+
+	// 같은 재고에 주문 출고 30 건과 직접 출고 30 건을 동시에 요청
+	type request struct {
+		method  string
+		path    string
+		payload interface{}
+	}
+	var requests []request
+	for i := 1; i <= 30; i++ {
+		CreateTestOrder(db, 1, models.ORDER_STATUS_PICKING, []models.OrderItem{{ProductID: 1, Quantity: 1}})
+		requests = append(requests,
+			request{"PUT", fmt.Sprintf("/orders/%d/status", i), dto.UpdateOrderStatusDTO{Status: models.ORDER_STATUS_SHIPPED}},
+			request{"POST", "/transactions", dto.CreateTransactionDTO{InventoryID: 1, Type: "OUT", Quantity: 1}},
+		)
+	}
+
+	codes := make([]int, len(requests))
+	var wg sync.WaitGroup
+	for i, r := range requests {
+		wg.Add(1)
+		go func(i int, r request) {
+			defer wg.Done()
+
+			jsonPayload, _ := json.Marshal(r.payload)
+			req := httptest.NewRequest(r.method, r.path, bytes.NewBuffer(jsonPayload))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+			codes[i] = rr.Code
+		}(i, r)
+	}
+	wg.Wait()
+
+	for i, code := range codes {
+		if code != http.StatusOK && code != http.StatusCreated {
+			t.Errorf("Expected %s %s to succeed, got %d", requests[i].method, requests[i].path, code)
+		}
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+	if inventory.Quantity != 40 {
+		t.Errorf("Expected inventory quantity to be 40, got %d", inventory.Quantity)
+	}
+
+	var shipped int64
+	db.Model(&models.Order{}).Where("status = ?", models.ORDER_STATUS_SHIPPED).Count(&shipped)
+	if shipped != 30 {
+		t.Errorf("Expected 30 shipped orders, got %d", shipped)
+	}
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"github.com/jhphon0730/StockFlow/internal/models"
 	"github.com/jhphon0730/StockFlow/internal/services"
 	"github.com/jhphon0730/StockFlow/pkg/dto"
 	"github.com/jhphon0730/StockFlow/pkg/utils"
@@ -16,7 +17,10 @@ type ProductHandler interface {
 	GetAllProducts(c *gin.Context)
 	GetProduct(c *gin.Context)
 	CreateProduct(c *gin.Context)
+	UpdateProduct(c *gin.Context)
 	DeleteProduct(c *gin.Context)
+	UpdateProductUnits(c *gin.Context)
+	UpdateProductAttributes(c *gin.Context)
 }
 
 type productHandler struct {
@@ -32,15 +36,21 @@ func NewProductHandler(productservice services.ProductService) ProductHandler {
 func (p *productHandler) GetAllProducts(c *gin.Context) {
 	ctx := c.Request.Context()
 	search_filter := utils.GetProductSearchQuery(c)
+	page, err := utils.GetPageQuery(c, models.PRODUCT_SORT_FIELDS, 0)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
 
-	status, products, err := p.productService.FindAll(ctx, search_filter)
+	status, products, pagination, err := p.productService.FindAll(ctx, search_filter, page)
 	if err != nil {
 		utils.JSONResponse(c, status, nil, err)
 		return
 	}
 
 	res_data := gin.H{
-		"products": products,
+		"products":   products,
+		"pagination": pagination,
 	}
 
 	utils.JSONResponse(c, status, res_data, nil)
@@ -122,3 +132,121 @@ func (p *productHandler) DeleteProduct(c *gin.Context) {
 
 	utils.JSONResponse(c, status, nil, nil)
 }
+
+// 제품의 환산 단위 전체 교체
+func (p *productHandler) UpdateProductUnits(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var updateProductUnitsDTO dto.UpdateProductUnitsDTO
+	if err := c.ShouldBindJSON(&updateProductUnitsDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := updateProductUnitsDTO.CheckUpdateProductUnitsDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, product, err := p.productService.UpdateUnits(uint(id_int), updateProductUnitsDTO.ToModel(), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"product": product,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 제품의 사용자 정의 속성 전체 교체
+func (p *productHandler) UpdateProductAttributes(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var updateProductAttributesDTO dto.UpdateProductAttributesDTO
+	if err := c.ShouldBindJSON(&updateProductAttributesDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := updateProductAttributesDTO.CheckUpdateProductAttributesDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, product, err := p.productService.UpdateAttributes(uint(id_int), updateProductAttributesDTO.ToModel(), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"product": product,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 제품 수정 ( PATCH: 입력한 항목만 변경, PUT: 전체 변경 )
+func (p *productHandler) UpdateProduct(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var updateProductDTO dto.UpdateProductDTO
+	if err := c.ShouldBindJSON(&updateProductDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	replace := c.Request.Method == http.MethodPut
+	if ok, err := updateProductDTO.CheckUpdateProductDTO(replace); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, product, err := p.productService.Update(uint(id_int), updateProductDTO.ToUpdates(replace), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"product": product,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
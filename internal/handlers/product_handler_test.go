@@ -14,6 +14,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 )
 
@@ -21,7 +22,8 @@ func setup() (*gorm.DB, *gin.Engine, repositories.ProductRepository, services.Pr
 	// Test DB 초기화
 	db := SetupTestDB()
 	productRepo := repositories.NewProductRepository(db)
-	productService := services.NewProductService(productRepo)
+	categoryRepo := repositories.NewCategoryRepository(db)
+	productService := services.NewProductService(productRepo, categoryRepo)
 	productHandler := handlers.NewProductHandler(productService)
 
 	router := gin.Default()
@@ -176,3 +178,272 @@ func TestDeleteProduct(t *testing.T) {
 		t.Fatalf("Expected status %v, got %v", http.StatusOK, rr.Code)
 	}
 }
+
+func TestUpdateProductUnits(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, productRepo, _, productHandler := setup()
+	router.PUT("/products/:id/units", productHandler.UpdateProductUnits)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+
+	for _, tc := range []struct {
+		payload  dto.UpdateProductUnitsDTO
+		expected int
+	}{
+		{dto.UpdateProductUnitsDTO{Units: []dto.ProductUnitDTO{{Name: models.UNIT_EACH, Factor: 1}}}, http.StatusBadRequest},
+		{dto.UpdateProductUnitsDTO{Units: []dto.ProductUnitDTO{{Name: models.UNIT_CASE, Factor: 0}}}, http.StatusBadRequest},
+		{dto.UpdateProductUnitsDTO{Units: []dto.ProductUnitDTO{{Name: models.UNIT_CASE, Factor: 24}}}, http.StatusOK},
+		{dto.UpdateProductUnitsDTO{Units: []dto.ProductUnitDTO{{Name: models.UNIT_INNER_PACK, Factor: 6}, {Name: models.UNIT_CASE, Factor: 12}}}, http.StatusOK},
+	} {
+		jsonPayload, err := json.Marshal(tc.payload)
+		if err != nil {
+			t.Fatalf("Failed to marshal JSON payload: %v", err)
+		}
+
+		req, err := http.NewRequest("PUT", "/products/1/units", bytes.NewBuffer(jsonPayload))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status %v, got %v", tc.expected, rr.Code)
+		}
+	}
+
+	product, err := productRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find product: %v", err)
+	}
+
+	if product.BaseUnit != models.UNIT_EACH || len(product.Units) != 2 {
+		t.Fatalf("Expected base unit EA with 2 units, got %s with %d", product.BaseUnit, len(product.Units))
+	}
+
+	if factor, _ := product.UnitFactor(models.UNIT_CASE); factor != 12 {
+		t.Errorf("Expected CASE factor 12, got %d", factor)
+	}
+}
+
+func TestGetAllProductsByCategoryAndAttribute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, productHandler := setup()
+	router.POST("/products", productHandler.CreateProduct)
+	router.GET("/products", productHandler.GetAllProducts)
+	router.GET("/products/:id", productHandler.GetProduct)
+
+	parentCategory := models.Category{Name: "Apparel"}
+	db.Create(&parentCategory)
+	childCategory := models.Category{Name: "Shirts", ParentID: &parentCategory.ID}
+	db.Create(&childCategory)
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+
+	parentID, variantID := uint(1), uint(2)
+	for _, tc := range []struct {
+		payload  dto.CreateProductDTO
+		expected int
+	}{
+		{dto.CreateProductDTO{Name: "Shirt", SKU: "SHIRT", CategoryID: &childCategory.ID}, http.StatusCreated},
+		{dto.CreateProductDTO{Name: "Shirt Red M", SKU: "SHIRT-RED-M", CategoryID: &childCategory.ID, ParentID: &parentID, Attributes: []dto.ProductAttributeDTO{
+			{Name: "color", Value: "red"}, {Name: "size", Value: "M"},
+		}}, http.StatusCreated},
+		{dto.CreateProductDTO{Name: "Shirt Blue M", SKU: "SHIRT-BLUE-M", CategoryID: &childCategory.ID, ParentID: &parentID, Attributes: []dto.ProductAttributeDTO{
+			{Name: "color", Value: "blue"}, {Name: "size", Value: "M"}, {Name: "weight", Type: models.ATTRIBUTE_TYPE_NUMBER, Value: "0.2"},
+		}}, http.StatusCreated},
+		{dto.CreateProductDTO{Name: "Invalid", SKU: "INVALID", Attributes: []dto.ProductAttributeDTO{
+			{Name: "weight", Type: models.ATTRIBUTE_TYPE_NUMBER, Value: "heavy"},
+		}}, http.StatusBadRequest},
+		{dto.CreateProductDTO{Name: "Nested", SKU: "NESTED", ParentID: &variantID}, http.StatusBadRequest},
+	} {
+		rr := postTransferOrder(router, t, "/products", tc.payload)
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status %v, got %v", tc.expected, rr.Code)
+		}
+	}
+
+	CreateTestInventory(db, 1, 1, 1)
+	CreateTestInventory(db, 2, 1, 4)
+	CreateTestInventory(db, 3, 1, 5)
+
+	for _, tc := range []struct {
+		query    string
+		expected int
+	}{
+		{"?category_id=1", 3},
+		{"?category_id=1&attr[size]=M", 2},
+		{"?attr[color]=red&attr[size]=M", 1},
+		{"?parent_id=1", 2},
+	} {
+		req, err := http.NewRequest("GET", "/products"+tc.query, nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		var resp struct {
+			Response
+			Data struct {
+				Products []models.Product `json:"products"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to parse JSON response: %v", err)
+		}
+
+		if len(resp.Data.Products) != tc.expected {
+			t.Errorf("%s: expected %d products, got %d", tc.query, tc.expected, len(resp.Data.Products))
+		}
+	}
+
+	req, err := http.NewRequest("GET", "/products/1", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	var resp struct {
+		Response
+		Data struct {
+			Product *models.Product `json:"product"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse JSON response: %v", err)
+	}
+
+	if len(resp.Data.Product.Variants) != 2 || resp.Data.Product.StockQuantity != 1 || resp.Data.Product.RollupQuantity != 10 {
+		t.Errorf("Expected 2 variants rolling up to 10, got %d variants, %d / %d", len(resp.Data.Product.Variants), resp.Data.Product.StockQuantity, resp.Data.Product.RollupQuantity)
+	}
+}
+
+// JSON 본문으로 요청 ( PUT, PATCH 등 )
+func sendJSON(router *gin.Engine, t *testing.T, method, path string, payload interface{}) *httptest.ResponseRecorder {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	req, err := http.NewRequest(method, path, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+// JSON 본문으로 POST 요청
+func postJSON(router *gin.Engine, t *testing.T, path string, payload interface{}) *httptest.ResponseRecorder {
+	return sendJSON(router, t, "POST", path, payload)
+}
+
+func TestUpdateProduct(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, productRepo, _, productHandler := setup()
+	router.PUT("/products/:id", productHandler.UpdateProduct)
+	router.PATCH("/products/:id", productHandler.UpdateProduct)
+
+	product, err := CreateTestProduct(db, "Prodcut Typo", "UPD-001")
+	if err != nil {
+		t.Fatalf("Failed to create test product: %v", err)
+	}
+	if err := db.Model(product).Update("description", "keep me").Error; err != nil {
+		t.Fatalf("Failed to update description: %v", err)
+	}
+	other, err := CreateTestProduct(db, "Other Product", "UPD-002")
+	if err != nil {
+		t.Fatalf("Failed to create test product: %v", err)
+	}
+	warehouse, err := CreateTestWarehouse(db, "Warehouse", "Location")
+	if err != nil {
+		t.Fatalf("Failed to create test warehouse: %v", err)
+	}
+	if _, err := CreateTestInventory(db, product.ID, warehouse.ID, 10); err != nil {
+		t.Fatalf("Failed to create test inventory: %v", err)
+	}
+
+	path := "/products/" + strconv.Itoa(int(product.ID))
+	for _, tc := range []struct {
+		method   string
+		path     string
+		payload  map[string]interface{}
+		expected int
+	}{
+		{"PATCH", path, map[string]interface{}{"name": "Product Fixed"}, http.StatusOK},
+		{"PATCH", path, map[string]interface{}{"sku": other.SKU}, http.StatusConflict},
+		{"PATCH", path, map[string]interface{}{"name": ""}, http.StatusBadRequest},
+		{"PATCH", path, map[string]interface{}{"category_id": 999}, http.StatusBadRequest},
+		{"PATCH", path, map[string]interface{}{"parent_id": product.ID}, http.StatusBadRequest},
+		{"PUT", path, map[string]interface{}{"name": "Product Fixed"}, http.StatusBadRequest}, // SKU 누락
+		{"PATCH", "/products/999", map[string]interface{}{"name": "Missing"}, http.StatusNotFound},
+	} {
+		rr := sendJSON(router, t, tc.method, tc.path, tc.payload)
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d for %s %v, got %d: %s", tc.expected, tc.method, tc.payload, rr.Code, rr.Body.String())
+		}
+	}
+
+	updated, err := productRepo.FindByID(product.ID)
+	if err != nil {
+		t.Fatalf("Failed to find product: %v", err)
+	}
+	if updated.Name != "Product Fixed" || updated.SKU != "UPD-001" || updated.Description != "keep me" {
+		t.Errorf("Expected only name to change, got %+v", updated)
+	}
+
+	// PUT 은 입력하지 않은 선택 항목을 비움
+	rr := sendJSON(router, t, "PUT", path, map[string]interface{}{"name": "Product Fixed", "sku": "UPD-003"})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+	updated, err = productRepo.FindByID(product.ID)
+	if err != nil {
+		t.Fatalf("Failed to find product: %v", err)
+	}
+	if updated.SKU != "UPD-003" || updated.Description != "" {
+		t.Errorf("Expected SKU UPD-003 with empty description, got %+v", updated)
+	}
+
+	// 재고는 그대로 유지
+	if len(updated.Inventories) != 1 || updated.Inventories[0].Quantity != 10 {
+		t.Errorf("Expected inventory to be kept, got %+v", updated.Inventories)
+	}
+
+	// PATCH 에서 생략한 분류는 유지하고 null 로 보낸 분류는 해제
+	category := models.Category{Name: "Category"}
+	if err := db.Create(&category).Error; err != nil {
+		t.Fatalf("Failed to create category: %v", err)
+	}
+	for _, payload := range []map[string]interface{}{{"category_id": category.ID}, {"name": "Product Categorized"}} {
+		if rr := sendJSON(router, t, "PATCH", path, payload); rr.Code != http.StatusOK {
+			t.Fatalf("Expected status code %d for %v, got %d: %s", http.StatusOK, payload, rr.Code, rr.Body.String())
+		}
+	}
+	updated, err = productRepo.FindByID(product.ID)
+	if err != nil {
+		t.Fatalf("Failed to find product: %v", err)
+	}
+	if updated.CategoryID == nil || *updated.CategoryID != category.ID {
+		t.Fatalf("Expected category %d to be kept, got %v", category.ID, updated.CategoryID)
+	}
+
+	if rr := sendJSON(router, t, "PATCH", path, map[string]interface{}{"category_id": nil}); rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+	updated, err = productRepo.FindByID(product.ID)
+	if err != nil {
+		t.Fatalf("Failed to find product: %v", err)
+	}
+	if updated.CategoryID != nil {
+		t.Errorf("Expected category to be cleared, got %d", *updated.CategoryID)
+	}
+}
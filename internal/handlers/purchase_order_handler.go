@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type PurchaseOrderHandler interface {
+	GetAllPurchaseOrders(c *gin.Context)
+	GetPurchaseOrder(c *gin.Context)
+	CreatePurchaseOrder(c *gin.Context)
+	UpdatePurchaseOrder(c *gin.Context)
+	OrderPurchaseOrder(c *gin.Context)
+	ReceivePurchaseOrder(c *gin.Context)
+	CancelPurchaseOrder(c *gin.Context)
+	DeletePurchaseOrder(c *gin.Context)
+}
+
+type purchaseOrderHandler struct {
+	purchaseOrderService services.PurchaseOrderService
+}
+
+func NewPurchaseOrderHandler(purchaseOrderService services.PurchaseOrderService) PurchaseOrderHandler {
+	return &purchaseOrderHandler{
+		purchaseOrderService: purchaseOrderService,
+	}
+}
+
+func (p *purchaseOrderHandler) GetAllPurchaseOrders(c *gin.Context) {
+	search_filter := utils.GetPurchaseOrderSearchQuery(c)
+
+	status, purchaseOrders, err := p.purchaseOrderService.FindAll(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"purchase_orders": purchaseOrders,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (p *purchaseOrderHandler) GetPurchaseOrder(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, purchaseOrder, err := p.purchaseOrderService.FindByID(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"purchase_order": purchaseOrder,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (p *purchaseOrderHandler) CreatePurchaseOrder(c *gin.Context) {
+	var createPurchaseOrderDTO dto.CreatePurchaseOrderDTO
+	if err := c.ShouldBindJSON(&createPurchaseOrderDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := createPurchaseOrderDTO.CheckCreatePurchaseOrderDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, purchaseOrder, err := p.purchaseOrderService.Create(createPurchaseOrderDTO.ToModel(c.GetUint("userID")))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"purchase_order": purchaseOrder,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 작성 중인 발주 수정 ( 생성과 같은 형식으로 항목 전체 교체 )
+func (p *purchaseOrderHandler) UpdatePurchaseOrder(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var updatePurchaseOrderDTO dto.CreatePurchaseOrderDTO
+	if err := c.ShouldBindJSON(&updatePurchaseOrderDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := updatePurchaseOrderDTO.CheckCreatePurchaseOrderDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, purchaseOrder, err := p.purchaseOrderService.Update(uint(id_int), updatePurchaseOrderDTO.ToModel(c.GetUint("userID")))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"purchase_order": purchaseOrder,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (p *purchaseOrderHandler) OrderPurchaseOrder(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, purchaseOrder, err := p.purchaseOrderService.Order(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"purchase_order": purchaseOrder,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (p *purchaseOrderHandler) ReceivePurchaseOrder(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var receivePurchaseOrderDTO dto.ReceivePurchaseOrderDTO
+	if err := c.ShouldBindJSON(&receivePurchaseOrderDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := receivePurchaseOrderDTO.CheckReceivePurchaseOrderDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, purchaseOrder, err := p.purchaseOrderService.Receive(uint(id_int), receivePurchaseOrderDTO.ToReceipts(), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"purchase_order": purchaseOrder,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (p *purchaseOrderHandler) CancelPurchaseOrder(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, purchaseOrder, err := p.purchaseOrderService.Cancel(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"purchase_order": purchaseOrder,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (p *purchaseOrderHandler) DeletePurchaseOrder(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, err := p.purchaseOrderService.Delete(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	utils.JSONResponse(c, status, nil, nil)
+}
@@ -0,0 +1,126 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func setupPurchaseOrder() (*gorm.DB, *gin.Engine, repositories.InventoryRepository, handlers.PurchaseOrderHandler) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	transactionRepo := repositories.NewTransactionRepository(db)
+	supplierRepo := repositories.NewSupplierRepository(db)
+	purchaseOrderRepo := repositories.NewPurchaseOrderRepository(db)
+	lotRepo := repositories.NewLotRepository(db)
+	serialNumberRepo := repositories.NewSerialNumberRepository(db)
+	binLocationRepo := repositories.NewBinLocationRepository(db)
+	stockAlertRepo := repositories.NewStockAlertRepository(db)
+	costLayerRepo := repositories.NewCostLayerRepository(db)
+	transactionService := services.NewTransactionService(transactionRepo, inventoryRepo, repositories.NewWarehouseRepository(db), lotRepo, serialNumberRepo, binLocationRepo, stockAlertRepo, costLayerRepo)
+	purchaseOrderService := services.NewPurchaseOrderService(purchaseOrderRepo, supplierRepo, inventoryRepo, transactionRepo, transactionService)
+	purchaseOrderHandler := handlers.NewPurchaseOrderHandler(purchaseOrderService)
+
+	router := gin.Default()
+	router.POST("/purchase-orders", purchaseOrderHandler.CreatePurchaseOrder)
+	router.POST("/purchase-orders/:id/order", purchaseOrderHandler.OrderPurchaseOrder)
+	router.POST("/purchase-orders/:id/receive", purchaseOrderHandler.ReceivePurchaseOrder)
+	return db, router, inventoryRepo, purchaseOrderHandler
+}
+
+func decodePurchaseOrder(t *testing.T, body []byte) *models.PurchaseOrder {
+	var resp struct {
+		Response
+		Data struct {
+			PurchaseOrder *models.PurchaseOrder `json:"purchase_order"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	return resp.Data.PurchaseOrder
+}
+
+func TestReceivePurchaseOrderPartially(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _ := setupPurchaseOrder()
+
+	CreateTestSupplier(db, "TestSupplier")
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+
+	rr := postTransferOrder(router, t, "/purchase-orders", dto.CreatePurchaseOrderDTO{
+		SupplierID:  1,
+		WarehouseID: 1,
+		Lines:       []dto.CreatePurchaseOrderLineDTO{{ProductID: 1, OrderedQuantity: 10}},
+	})
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	// 발주 전에는 입고 불가
+	receive := dto.ReceivePurchaseOrderDTO{Lines: []dto.ReceivePurchaseOrderLineDTO{{LineID: 1, Quantity: 4}}}
+	rr = postTransferOrder(router, t, "/purchase-orders/1/receive", receive)
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+
+	rr = postTransferOrder(router, t, "/purchase-orders/1/order", nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	rr = postTransferOrder(router, t, "/purchase-orders/1/receive", receive)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	purchaseOrder := decodePurchaseOrder(t, rr.Body.Bytes())
+	if purchaseOrder.Status != models.PURCHASE_STATUS_PARTIALLY_RECEIVED {
+		t.Errorf("Expected status %s, got %s", models.PURCHASE_STATUS_PARTIALLY_RECEIVED, purchaseOrder.Status)
+	}
+	if purchaseOrder.Lines[0].ReceivedQuantity != 4 || purchaseOrder.Lines[0].OutstandingQuantity != 6 {
+		t.Errorf("Expected received 4 / outstanding 6, got %d / %d", purchaseOrder.Lines[0].ReceivedQuantity, purchaseOrder.Lines[0].OutstandingQuantity)
+	}
+
+	// 미입고 수량보다 많이 입고 불가
+	rr = postTransferOrder(router, t, "/purchase-orders/1/receive", dto.ReceivePurchaseOrderDTO{Lines: []dto.ReceivePurchaseOrderLineDTO{{LineID: 1, Quantity: 7}}})
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+
+	rr = postTransferOrder(router, t, "/purchase-orders/1/receive", dto.ReceivePurchaseOrderDTO{Lines: []dto.ReceivePurchaseOrderLineDTO{{LineID: 1, Quantity: 6}}})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	purchaseOrder = decodePurchaseOrder(t, rr.Body.Bytes())
+	if purchaseOrder.Status != models.PURCHASE_STATUS_RECEIVED || purchaseOrder.ReceivedAt == nil {
+		t.Errorf("Expected status %s with received_at, got %s", models.PURCHASE_STATUS_RECEIVED, purchaseOrder.Status)
+	}
+
+	inventory, err := inventoryRepo.FindByWarehouseAndProduct(1, 1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+	if inventory.Quantity != 10 {
+		t.Errorf("Expected inventory quantity 10, got %d", inventory.Quantity)
+	}
+
+	var count int64
+	db.Model(&models.Transaction{}).Where("reference = ? AND type = ?", purchaseOrder.Reference, "IN").Count(&count)
+	if count != 2 {
+		t.Errorf("Expected 2 IN transactions, got %d", count)
+	}
+}
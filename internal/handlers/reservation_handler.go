@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type ReservationHandler interface {
+	GetAllReservations(c *gin.Context)
+	GetReservation(c *gin.Context)
+	CreateReservation(c *gin.Context)
+	ReleaseReservation(c *gin.Context)
+}
+
+type reservationHandler struct {
+	reservationService services.ReservationService
+}
+
+func NewReservationHandler(reservationService services.ReservationService) ReservationHandler {
+	return &reservationHandler{
+		reservationService: reservationService,
+	}
+}
+
+func (r *reservationHandler) GetAllReservations(c *gin.Context) {
+	search_filter := utils.GetReservationSearchQuery(c)
+
+	status, reservations, err := r.reservationService.FindAll(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"reservations": reservations,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (r *reservationHandler) GetReservation(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, reservation, err := r.reservationService.FindByID(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"reservation": reservation,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (r *reservationHandler) CreateReservation(c *gin.Context) {
+	ctx := c.Request.Context()
+	var createReservationDTO dto.CreateReservationDTO
+	if err := c.ShouldBindJSON(&createReservationDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := createReservationDTO.CheckCreateReservationDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, reservation, err := r.reservationService.Create(createReservationDTO.ToModel(), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"reservation": reservation,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (r *reservationHandler) ReleaseReservation(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, reservation, err := r.reservationService.Release(uint(id_int), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"reservation": reservation,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
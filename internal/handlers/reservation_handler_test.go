@@ -0,0 +1,188 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func setupReservation() (*gorm.DB, *gin.Engine, repositories.InventoryRepository, services.ReservationService, handlers.ReservationHandler) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	reservationRepo := repositories.NewReservationRepository(db)
+	reservationService := services.NewReservationService(reservationRepo, inventoryRepo)
+	reservationHandler := handlers.NewReservationHandler(reservationService)
+
+	router := gin.Default()
+	return db, router, inventoryRepo, reservationService, reservationHandler
+}
+
+func postReservation(router *gin.Engine, t *testing.T, payload dto.CreateReservationDTO) *httptest.ResponseRecorder {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	req, err := http.NewRequest("POST", "/reservations", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestCreateReservation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, reservationHandler := setupReservation()
+	router.POST("/reservations", reservationHandler.CreateReservation)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+
+	rr := postReservation(router, t, dto.CreateReservationDTO{
+		InventoryID:      1,
+		Quantity:         4,
+		OwnerReference:   "ORDER-1",
+		ExpiresInMinutes: 30,
+	})
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+
+	if inventory.Quantity != 10 {
+		t.Errorf("Expected on-hand quantity to be 10, got %d", inventory.Quantity)
+	}
+
+	if inventory.ReservedQuantity != 4 {
+		t.Errorf("Expected reserved quantity to be 4, got %d", inventory.ReservedQuantity)
+	}
+
+	if inventory.AvailableQuantity != 6 {
+		t.Errorf("Expected available quantity to be 6, got %d", inventory.AvailableQuantity)
+	}
+
+	// 가용 수량 초과 예약
+	rr = postReservation(router, t, dto.CreateReservationDTO{
+		InventoryID:      1,
+		Quantity:         7,
+		OwnerReference:   "ORDER-2",
+		ExpiresInMinutes: 30,
+	})
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+}
+
+func TestReleaseReservation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, reservationHandler := setupReservation()
+	router.POST("/reservations", reservationHandler.CreateReservation)
+	router.POST("/reservations/:id/release", reservationHandler.ReleaseReservation)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+
+	postReservation(router, t, dto.CreateReservationDTO{
+		InventoryID:      1,
+		Quantity:         4,
+		OwnerReference:   "ORDER-1",
+		ExpiresInMinutes: 30,
+	})
+
+	req, err := http.NewRequest("POST", "/reservations/1/release", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+
+	if inventory.AvailableQuantity != 10 {
+		t.Errorf("Expected available quantity to be 10, got %d", inventory.AvailableQuantity)
+	}
+
+	// 이미 해제된 예약
+	req, err = http.NewRequest("POST", "/reservations/1/release", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+}
+
+func TestReleaseExpiredReservations(t *testing.T) {
+	db, _, inventoryRepo, reservationService, _ := setupReservation()
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+
+	reservations := []models.Reservation{
+		{InventoryID: 1, Quantity: 3, OwnerReference: "ORDER-1", Status: models.RESERVATION_STATUS_ACTIVE, ExpiresAt: time.Now().Add(-time.Minute)},
+		{InventoryID: 1, Quantity: 2, OwnerReference: "ORDER-2", Status: models.RESERVATION_STATUS_ACTIVE, ExpiresAt: time.Now().Add(time.Hour)},
+	}
+	for i := range reservations {
+		db.Create(&reservations[i])
+	}
+	db.Model(&models.Inventory{}).Where("id = ?", 1).Update("reserved_quantity", 5)
+
+	_, released, err := reservationService.ReleaseExpired(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to release expired reservations: %v", err)
+	}
+
+	if released != 1 {
+		t.Errorf("Expected 1 released reservation, got %d", released)
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+
+	if inventory.ReservedQuantity != 2 {
+		t.Errorf("Expected reserved quantity to be 2, got %d", inventory.ReservedQuantity)
+	}
+
+	var expired models.Reservation
+	db.First(&expired, reservations[0].ID)
+	if expired.Status != models.RESERVATION_STATUS_EXPIRED {
+		t.Errorf("Expected status %s, got %s", models.RESERVATION_STATUS_EXPIRED, expired.Status)
+	}
+}
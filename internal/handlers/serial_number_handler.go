@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+)
+
+type SerialNumberHandler interface {
+	GetSerialNumber(c *gin.Context)
+}
+
+type serialNumberHandler struct {
+	serialNumberService services.SerialNumberService
+}
+
+func NewSerialNumberHandler(serialNumberService services.SerialNumberService) SerialNumberHandler {
+	return &serialNumberHandler{
+		serialNumberService: serialNumberService,
+	}
+}
+
+func (s *serialNumberHandler) GetSerialNumber(c *gin.Context) {
+	serial := c.Param("serial")
+	if serial == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("serial is required"))
+		return
+	}
+
+	status, serialNumber, err := s.serialNumberService.FindBySerial(serial)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"serial_number": serialNumber,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
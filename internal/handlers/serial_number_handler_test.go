@@ -0,0 +1,158 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupSerialNumber() (*gorm.DB, *gin.Engine, handlers.TransactionHandler, handlers.SerialNumberHandler) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	transactionRepo := repositories.NewTransactionRepository(db)
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	lotRepo := repositories.NewLotRepository(db)
+	serialNumberRepo := repositories.NewSerialNumberRepository(db)
+	binLocationRepo := repositories.NewBinLocationRepository(db)
+	stockAlertRepo := repositories.NewStockAlertRepository(db)
+	costLayerRepo := repositories.NewCostLayerRepository(db)
+	transactionService := services.NewTransactionService(transactionRepo, inventoryRepo, repositories.NewWarehouseRepository(db), lotRepo, serialNumberRepo, binLocationRepo, stockAlertRepo, costLayerRepo)
+	transactionHandler := handlers.NewTransactionHandler(transactionService)
+	serialNumberService := services.NewSerialNumberService(serialNumberRepo)
+	serialNumberHandler := handlers.NewSerialNumberHandler(serialNumberService)
+
+	// 일련번호 관리 제품과 두 창고의 재고 생성
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Model(product).Update("serialized", true)
+	CreateTestWarehouse(db, "TestWarehouse1", "TestLocation1")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	CreateTestInventory(db, 1, 1, 0)
+	CreateTestInventory(db, 1, 2, 0)
+
+	router := gin.Default()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+	router.GET("/serials/:serial", serialNumberHandler.GetSerialNumber)
+	return db, router, transactionHandler, serialNumberHandler
+}
+
+func postSerialTransaction(t *testing.T, router *gin.Engine, payload dto.CreateTransactionDTO) *httptest.ResponseRecorder {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	req, err := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestSerializedTransactionRequiresSerials(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	_, router, _, _ := setupSerialNumber()
+
+	rr := postSerialTransaction(t, router, dto.CreateTransactionDTO{InventoryID: 1, Quantity: 2, Type: "IN", Serials: []string{"SN-1"}})
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+}
+
+func TestSerializedTransactionUniqueAcrossWarehouses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	_, router, _, _ := setupSerialNumber()
+
+	rr := postSerialTransaction(t, router, dto.CreateTransactionDTO{InventoryID: 1, Quantity: 2, Type: "IN", Serials: []string{"SN-1", "SN-2"}})
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	// 다른 창고에 같은 일련번호 입고 불가
+	rr = postSerialTransaction(t, router, dto.CreateTransactionDTO{InventoryID: 2, Quantity: 1, Type: "IN", Serials: []string{"SN-2"}})
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+
+	// 다른 창고에 보관 중인 일련번호 출고 불가
+	rr = postSerialTransaction(t, router, dto.CreateTransactionDTO{InventoryID: 2, Quantity: 1, Type: "OUT", Serials: []string{"SN-1"}})
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+}
+
+func TestGetSerialNumberHistory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	_, router, _, _ := setupSerialNumber()
+
+	for _, payload := range []dto.CreateTransactionDTO{
+		{InventoryID: 1, Quantity: 1, Type: "IN", Serials: []string{"SN-1"}},
+		{InventoryID: 1, Quantity: 1, Type: "OUT", Serials: []string{"SN-1"}},
+		{InventoryID: 2, Quantity: 1, Type: "IN", Serials: []string{"SN-1"}},
+	} {
+		rr := postSerialTransaction(t, router, payload)
+		if rr.Code != http.StatusCreated {
+			t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+		}
+	}
+
+	req, err := http.NewRequest("GET", "/serials/SN-1", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			SerialNumber *models.SerialNumber `json:"serial_number"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if resp.Data.SerialNumber.InventoryID != 2 || resp.Data.SerialNumber.Status != models.SERIAL_STATUS_IN_STOCK {
+		t.Errorf("Expected SN-1 in stock at inventory 2, got %+v", resp.Data.SerialNumber)
+	}
+
+	if len(resp.Data.SerialNumber.Transactions) != 3 {
+		t.Fatalf("Expected 3 movements, got %d", len(resp.Data.SerialNumber.Transactions))
+	}
+
+	if resp.Data.SerialNumber.Transactions[1].Type != "OUT" {
+		t.Errorf("Expected second movement to be OUT, got %s", resp.Data.SerialNumber.Transactions[1].Type)
+	}
+
+	req, err = http.NewRequest("GET", "/serials/UNKNOWN", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, rr.Code)
+	}
+}
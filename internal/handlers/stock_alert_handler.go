@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type StockAlertHandler interface {
+	GetAllStockAlerts(c *gin.Context)
+	AcknowledgeStockAlert(c *gin.Context)
+}
+
+type stockAlertHandler struct {
+	stockAlertService services.StockAlertService
+}
+
+func NewStockAlertHandler(stockAlertService services.StockAlertService) StockAlertHandler {
+	return &stockAlertHandler{
+		stockAlertService: stockAlertService,
+	}
+}
+
+func (s *stockAlertHandler) GetAllStockAlerts(c *gin.Context) {
+	search_filter := utils.GetStockAlertSearchQuery(c)
+
+	status, stockAlerts, err := s.stockAlertService.FindAll(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"stock_alerts": stockAlerts,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (s *stockAlertHandler) AcknowledgeStockAlert(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, stockAlert, err := s.stockAlertService.Acknowledge(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"stock_alert": stockAlert,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
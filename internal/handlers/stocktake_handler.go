@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type StocktakeHandler interface {
+	GetAllStocktakes(c *gin.Context)
+	GetStocktake(c *gin.Context)
+	CreateStocktake(c *gin.Context)
+	SubmitStocktakeCounts(c *gin.Context)
+	GetStocktakeVariance(c *gin.Context)
+	ApproveStocktake(c *gin.Context)
+	CancelStocktake(c *gin.Context)
+}
+
+type stocktakeHandler struct {
+	stocktakeService services.StocktakeService
+}
+
+func NewStocktakeHandler(stocktakeService services.StocktakeService) StocktakeHandler {
+	return &stocktakeHandler{
+		stocktakeService: stocktakeService,
+	}
+}
+
+func (s *stocktakeHandler) GetAllStocktakes(c *gin.Context) {
+	search_filter := utils.GetStocktakeSearchQuery(c)
+
+	status, stocktakes, err := s.stocktakeService.FindAll(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"stocktakes": stocktakes,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (s *stocktakeHandler) GetStocktake(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, stocktake, err := s.stocktakeService.FindByID(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"stocktake": stocktake,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (s *stocktakeHandler) CreateStocktake(c *gin.Context) {
+	var createStocktakeDTO dto.CreateStocktakeDTO
+	if err := c.ShouldBindJSON(&createStocktakeDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := createStocktakeDTO.CheckCreateStocktakeDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, stocktake, err := s.stocktakeService.Create(createStocktakeDTO.ToModel(c.GetUint("userID")))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"stocktake": stocktake,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (s *stocktakeHandler) SubmitStocktakeCounts(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var submitStocktakeCountsDTO dto.SubmitStocktakeCountsDTO
+	if err := c.ShouldBindJSON(&submitStocktakeCountsDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := submitStocktakeCountsDTO.CheckSubmitStocktakeCountsDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, stocktake, err := s.stocktakeService.SubmitCounts(uint(id_int), submitStocktakeCountsDTO.ToCounts())
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"stocktake": stocktake,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 실사 차이 보고서 조회
+func (s *stocktakeHandler) GetStocktakeVariance(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, report, err := s.stocktakeService.GetVarianceReport(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"variance_report": report,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (s *stocktakeHandler) ApproveStocktake(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, stocktake, err := s.stocktakeService.Approve(uint(id_int), c.GetUint("userID"), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"stocktake": stocktake,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (s *stocktakeHandler) CancelStocktake(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, stocktake, err := s.stocktakeService.Cancel(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"stocktake": stocktake,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
@@ -0,0 +1,323 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func setupStocktake() (*gorm.DB, *gin.Engine) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	warehouseRepo := repositories.NewWarehouseRepository(db)
+	transactionRepo := repositories.NewTransactionRepository(db)
+	lotRepo := repositories.NewLotRepository(db)
+	serialNumberRepo := repositories.NewSerialNumberRepository(db)
+	binLocationRepo := repositories.NewBinLocationRepository(db)
+	stockAlertRepo := repositories.NewStockAlertRepository(db)
+	costLayerRepo := repositories.NewCostLayerRepository(db)
+	stocktakeRepo := repositories.NewStocktakeRepository(db)
+	transactionService := services.NewTransactionService(transactionRepo, inventoryRepo, warehouseRepo, lotRepo, serialNumberRepo, binLocationRepo, stockAlertRepo, costLayerRepo)
+	stocktakeService := services.NewStocktakeService(stocktakeRepo, warehouseRepo, inventoryRepo, binLocationRepo, transactionRepo, transactionService)
+	stocktakeHandler := handlers.NewStocktakeHandler(stocktakeService)
+
+	router := gin.Default()
+	router.POST("/stocktakes", stocktakeHandler.CreateStocktake)
+	router.POST("/stocktakes/:id/counts", stocktakeHandler.SubmitStocktakeCounts)
+	router.GET("/stocktakes/:id/variance", stocktakeHandler.GetStocktakeVariance)
+	router.POST("/stocktakes/:id/approve", stocktakeHandler.ApproveStocktake)
+	router.POST("/stocktakes/:id/cancel", stocktakeHandler.CancelStocktake)
+	return db, router
+}
+
+func decodeStocktake(t *testing.T, body []byte) *models.Stocktake {
+	var resp struct {
+		Response
+		Data struct {
+			Stocktake *models.Stocktake `json:"stocktake"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	return resp.Data.Stocktake
+}
+
+func TestStocktakeApproveAdjustsVariance(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router := setupStocktake()
+
+	CreateTestProduct(db, "TestProduct1", "TestSKU1")
+	CreateTestProduct(db, "TestProduct2", "TestSKU2")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+	CreateTestInventory(db, 2, 1, 5)
+
+	rr := postJSON(router, t, "/stocktakes", dto.CreateStocktakeDTO{WarehouseID: 1})
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	stocktake := decodeStocktake(t, rr.Body.Bytes())
+	if len(stocktake.Lines) != 2 || stocktake.Lines[0].ExpectedQuantity != 10 {
+		t.Fatalf("Expected 2 lines with frozen quantity 10, got %+v", stocktake.Lines)
+	}
+
+	// 실사 수량을 모두 입력하기 전에는 승인 불가
+	rr = postJSON(router, t, "/stocktakes/1/approve", nil)
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+
+	counted, matched := 8, 5
+	rr = postJSON(router, t, "/stocktakes/1/counts", dto.SubmitStocktakeCountsDTO{Lines: []dto.SubmitStocktakeCountDTO{
+		{LineID: stocktake.Lines[0].ID, CountedQuantity: &counted},
+		{LineID: stocktake.Lines[1].ID, CountedQuantity: &matched},
+	}})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	req, err := http.NewRequest("GET", "/stocktakes/1/variance", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	var varianceResp struct {
+		Response
+		Data struct {
+			VarianceReport *models.StocktakeVarianceReport `json:"variance_report"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &varianceResp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	report := varianceResp.Data.VarianceReport
+	if report.CountedLines != 2 || report.DiscrepancyLines != 1 || report.NetVariance != -2 {
+		t.Fatalf("Expected 2 counted / 1 discrepancy / -2 net, got %+v", report)
+	}
+
+	// 실사 중 출고가 발생해도 차이 수량만 반영
+	db.Model(&models.Inventory{}).Where("id = ?", 1).Update("quantity", 7)
+
+	rr = postJSON(router, t, "/stocktakes/1/approve", nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	stocktake = decodeStocktake(t, rr.Body.Bytes())
+	if stocktake.Status != models.STOCKTAKE_STATUS_APPROVED || stocktake.ApprovedAt == nil {
+		t.Errorf("Expected approved stocktake, got %s", stocktake.Status)
+	}
+
+	var inventory models.Inventory
+	db.First(&inventory, 1)
+	if inventory.Quantity != 5 {
+		t.Errorf("Expected inventory quantity 5, got %d", inventory.Quantity)
+	}
+
+	var transactions []models.Transaction
+	db.Where("reference = ?", stocktake.Reference).Find(&transactions)
+	if len(transactions) != 1 || transactions[0].Type != "ADJUST" || transactions[0].InventoryID != 1 {
+		t.Errorf("Expected 1 ADJUST transaction for inventory 1, got %+v", transactions)
+	}
+
+	// 다시 승인해도 차이 수량이 한 번 더 반영되지 않음
+	rr = postJSON(router, t, "/stocktakes/1/approve", nil)
+	if rr.Code != http.StatusConflict {
+		t.Errorf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+	db.First(&inventory, 1)
+	if inventory.Quantity != 5 {
+		t.Errorf("Expected inventory quantity 5 after second approval, got %d", inventory.Quantity)
+	}
+
+	// 존재하지 않는 실사
+	rr = postJSON(router, t, "/stocktakes/99/approve", nil)
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, rr.Code)
+	}
+}
+
+func TestStocktakeBinRange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router := setupStocktake()
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+	CreateTestBinLocation(db, 1, "A", "01")
+	CreateTestBinLocation(db, 1, "B", "01")
+	db.Create(&models.BinStock{InventoryID: 1, BinLocationID: 1, Quantity: 6})
+	db.Create(&models.BinStock{InventoryID: 1, BinLocationID: 2, Quantity: 4})
+
+	rr := postJSON(router, t, "/stocktakes", dto.CreateStocktakeDTO{WarehouseID: 1, BinCodePrefix: "A"})
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	stocktake := decodeStocktake(t, rr.Body.Bytes())
+	if len(stocktake.Lines) != 1 || stocktake.Lines[0].ExpectedQuantity != 6 {
+		t.Fatalf("Expected 1 line for bin A-01 with quantity 6, got %+v", stocktake.Lines)
+	}
+
+	counted := 9
+	rr = postJSON(router, t, "/stocktakes/1/counts", dto.SubmitStocktakeCountsDTO{Lines: []dto.SubmitStocktakeCountDTO{
+		{LineID: stocktake.Lines[0].ID, CountedQuantity: &counted},
+	}})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	rr = postJSON(router, t, "/stocktakes/1/approve", nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	var inventory models.Inventory
+	db.First(&inventory, 1)
+	if inventory.Quantity != 13 {
+		t.Errorf("Expected inventory quantity 13, got %d", inventory.Quantity)
+	}
+
+	var binStock models.BinStock
+	db.Where("inventory_id = ? AND bin_location_id = ?", 1, 1).First(&binStock)
+	if binStock.Quantity != 9 {
+		t.Errorf("Expected bin A-01 quantity 9, got %d", binStock.Quantity)
+	}
+}
+
+func TestStocktakeApproveLotTracked(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router := setupStocktake()
+
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Model(product).Update("lot_tracked", true)
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+	soon, later := time.Now().AddDate(0, 1, 0), time.Now().AddDate(0, 6, 0)
+	db.Create(&models.Lot{InventoryID: 1, LotNumber: "LOT-A", ExpiresAt: &soon, Quantity: 4})
+	db.Create(&models.Lot{InventoryID: 1, LotNumber: "LOT-B", ExpiresAt: &later, Quantity: 6})
+
+	// 부족 수량은 유통기한이 빠른 로트부터 차감, 초과 수량은 기본 로트에 입고
+	for i, counted := range []int{7, 9} {
+		rr := postJSON(router, t, "/stocktakes", dto.CreateStocktakeDTO{WarehouseID: 1})
+		if rr.Code != http.StatusCreated {
+			t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+		}
+		stocktake := decodeStocktake(t, rr.Body.Bytes())
+
+		path := fmt.Sprintf("/stocktakes/%d", stocktake.ID)
+		rr = postJSON(router, t, path+"/counts", dto.SubmitStocktakeCountsDTO{Lines: []dto.SubmitStocktakeCountDTO{
+			{LineID: stocktake.Lines[0].ID, CountedQuantity: &counted},
+		}})
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+		}
+
+		rr = postJSON(router, t, path+"/approve", nil)
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected stocktake %d to be approved, got %d: %s", i+1, rr.Code, rr.Body.String())
+		}
+	}
+
+	var lots []models.Lot
+	db.Order("lot_number").Find(&lots)
+	got := make(map[string]int)
+	for _, lot := range lots {
+		got[lot.LotNumber] = lot.Quantity
+	}
+	if len(got) != 3 || got["LOT-A"] != 1 || got["LOT-B"] != 6 || got[models.DEFAULT_LOT_NUMBER] != 2 {
+		t.Errorf("Expected LOT-A 1, LOT-B 6, %s 2, got %v", models.DEFAULT_LOT_NUMBER, got)
+	}
+
+	var inventory models.Inventory
+	db.First(&inventory, 1)
+	if inventory.Quantity != 9 {
+		t.Errorf("Expected inventory quantity 9, got %d", inventory.Quantity)
+	}
+}
+
+func TestStocktakeClosedStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router := setupStocktake()
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+
+	counted := 8
+	for i := 0; i < 2; i++ {
+		if rr := postJSON(router, t, "/stocktakes", dto.CreateStocktakeDTO{WarehouseID: 1}); rr.Code != http.StatusCreated {
+			t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+		}
+	}
+	var lines []models.StocktakeLine
+	db.Order("stocktake_id").Find(&lines)
+
+	// 실사 1 승인, 실사 2 취소
+	if rr := postJSON(router, t, "/stocktakes/1/counts", dto.SubmitStocktakeCountsDTO{Lines: []dto.SubmitStocktakeCountDTO{
+		{LineID: lines[0].ID, CountedQuantity: &counted},
+	}}); rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+	if rr := postJSON(router, t, "/stocktakes/1/approve", nil); rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+	if rr := postJSON(router, t, "/stocktakes/2/cancel", nil); rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	// 승인/취소된 실사는 다시 취소하거나 실사 수량을 변경할 수 없음
+	recount := 3
+	for i, tc := range []struct {
+		path     string
+		payload  interface{}
+		expected int
+	}{
+		{"/stocktakes/1/cancel", nil, http.StatusConflict},
+		{"/stocktakes/1/counts", dto.SubmitStocktakeCountsDTO{Lines: []dto.SubmitStocktakeCountDTO{{LineID: lines[0].ID, CountedQuantity: &recount}}}, http.StatusConflict},
+		{"/stocktakes/2/cancel", nil, http.StatusConflict},
+		{"/stocktakes/2/counts", dto.SubmitStocktakeCountsDTO{Lines: []dto.SubmitStocktakeCountDTO{{LineID: lines[1].ID, CountedQuantity: &recount}}}, http.StatusConflict},
+		// 존재하지 않는 실사
+		{"/stocktakes/99/cancel", nil, http.StatusNotFound},
+		{"/stocktakes/99/counts", dto.SubmitStocktakeCountsDTO{Lines: []dto.SubmitStocktakeCountDTO{{LineID: lines[0].ID, CountedQuantity: &recount}}}, http.StatusNotFound},
+	} {
+		if rr := postJSON(router, t, tc.path, tc.payload); rr.Code != tc.expected {
+			t.Errorf("Case %d: expected status code %d for %s, got %d", i, tc.expected, tc.path, rr.Code)
+		}
+	}
+
+	var stocktake models.Stocktake
+	db.Preload("Lines").First(&stocktake, 1)
+	if stocktake.Status != models.STOCKTAKE_STATUS_APPROVED || *stocktake.Lines[0].CountedQuantity != 8 {
+		t.Errorf("Expected approved stocktake with count 8, got %s with %d", stocktake.Status, *stocktake.Lines[0].CountedQuantity)
+	}
+
+	req, err := http.NewRequest("GET", "/stocktakes/99/variance", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, rr.Code)
+	}
+}
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type SupplierHandler interface {
+	GetAllSuppliers(c *gin.Context)
+	GetSupplier(c *gin.Context)
+	CreateSupplier(c *gin.Context)
+	UpdateSupplier(c *gin.Context)
+	DeleteSupplier(c *gin.Context)
+}
+
+type supplierHandler struct {
+	supplierService services.SupplierService
+}
+
+func NewSupplierHandler(supplierService services.SupplierService) SupplierHandler {
+	return &supplierHandler{
+		supplierService: supplierService,
+	}
+}
+
+func (s *supplierHandler) GetAllSuppliers(c *gin.Context) {
+	search_filter := utils.GetSupplierSearchQuery(c)
+
+	status, suppliers, err := s.supplierService.FindAll(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"suppliers": suppliers,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (s *supplierHandler) GetSupplier(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, supplier, err := s.supplierService.FindByID(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"supplier": supplier,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (s *supplierHandler) CreateSupplier(c *gin.Context) {
+	var createSupplierDTO dto.CreateSupplierDTO
+	if err := c.ShouldBindJSON(&createSupplierDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := createSupplierDTO.CheckCreateSupplierDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, supplier, err := s.supplierService.Create(createSupplierDTO.ToModel())
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"supplier": supplier,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (s *supplierHandler) UpdateSupplier(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var updateSupplierDTO dto.CreateSupplierDTO
+	if err := c.ShouldBindJSON(&updateSupplierDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := updateSupplierDTO.CheckCreateSupplierDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, supplier, err := s.supplierService.Update(uint(id_int), updateSupplierDTO.ToModel())
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"supplier": supplier,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (s *supplierHandler) DeleteSupplier(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, err := s.supplierService.Delete(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	utils.JSONResponse(c, status, nil, nil)
+}
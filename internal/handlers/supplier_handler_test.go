@@ -0,0 +1,89 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupSupplier() (*gorm.DB, *gin.Engine, handlers.SupplierHandler) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	supplierRepo := repositories.NewSupplierRepository(db)
+	purchaseOrderRepo := repositories.NewPurchaseOrderRepository(db)
+	supplierService := services.NewSupplierService(supplierRepo, purchaseOrderRepo)
+	supplierHandler := handlers.NewSupplierHandler(supplierService)
+
+	router := gin.Default()
+	return db, router, supplierHandler
+}
+
+func TestCreateSupplier(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	_, router, supplierHandler := setupSupplier()
+	router.POST("/suppliers", supplierHandler.CreateSupplier)
+
+	payload := dto.CreateSupplierDTO{Name: "TestSupplier", Email: "supplier@example.com"}
+	for _, expected := range []int{http.StatusCreated, http.StatusConflict} {
+		jsonPayload, err := json.Marshal(payload)
+		if err != nil {
+			t.Fatalf("Failed to marshal JSON payload: %v", err)
+		}
+
+		req, err := http.NewRequest("POST", "/suppliers", bytes.NewBuffer(jsonPayload))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != expected {
+			t.Fatalf("Expected status code %d, got %d", expected, rr.Code)
+		}
+	}
+}
+
+func TestUpdateSupplier(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, supplierHandler := setupSupplier()
+	router.PUT("/suppliers/:id", supplierHandler.UpdateSupplier)
+
+	CreateTestSupplier(db, "TestSupplier")
+
+	jsonPayload, err := json.Marshal(dto.CreateSupplierDTO{Name: "RenamedSupplier", Phone: "010-0000-0000"})
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	req, err := http.NewRequest("PUT", "/suppliers/1", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	var supplier models.Supplier
+	db.First(&supplier, 1)
+	if supplier.Name != "RenamedSupplier" || supplier.Phone != "010-0000-0000" {
+		t.Errorf("Expected supplier to be updated, got %+v", supplier)
+	}
+}
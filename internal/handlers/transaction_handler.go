@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"github.com/jhphon0730/StockFlow/internal/models"
 	"github.com/jhphon0730/StockFlow/internal/services"
 	"github.com/jhphon0730/StockFlow/pkg/dto"
 	"github.com/jhphon0730/StockFlow/pkg/utils"
@@ -16,7 +17,9 @@ type TransactionHandler interface {
 	GetAllTransactions(c *gin.Context)
 	GetTransaction(c *gin.Context)
 	CreateTransaction(c *gin.Context)
-	DeleteTransaction(c *gin.Context)
+	CreateTransactionBatch(c *gin.Context)
+	TransferTransaction(c *gin.Context)
+	ReverseTransaction(c *gin.Context)
 }
 
 type transactionHandler struct {
@@ -32,8 +35,14 @@ func NewTransactionHandler(transactionService services.TransactionService) Trans
 func (t *transactionHandler) GetAllTransactions(c *gin.Context) {
 	ctx := c.Request.Context()
 	search_filter := utils.GetTransactionSearchQuery(c)
+	// 재고내역은 계속 쌓이므로 페이지 조건이 없어도 기본 페이지 크기만 조회
+	page, err := utils.GetPageQuery(c, models.TRANSACTION_SORT_FIELDS, models.DEFAULT_PAGE_SIZE)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
 
-	status, transactions, err := t.transactionService.FindAll(ctx, search_filter)
+	status, transactions, pagination, err := t.transactionService.FindAll(ctx, search_filter, page)
 	if err != nil {
 		utils.JSONResponse(c, status, nil, err)
 		return
@@ -41,6 +50,7 @@ func (t *transactionHandler) GetAllTransactions(c *gin.Context) {
 
 	res_data := gin.H{
 		"transactions": transactions,
+		"pagination":   pagination,
 	}
 
 	utils.JSONResponse(c, status, res_data, nil)
@@ -98,7 +108,74 @@ func (t *transactionHandler) CreateTransaction(c *gin.Context) {
 	utils.JSONResponse(c, status, res_data, nil)
 }
 
-func (t *transactionHandler) DeleteTransaction(c *gin.Context) {
+// 여러 재고내역을 한 번에 생성 ( BEST_EFFORT 모드에서 일부 항목이 실패하면 207 과 항목별 결과 반환 )
+func (t *transactionHandler) CreateTransactionBatch(c *gin.Context) {
+	ctx := c.Request.Context()
+	var createTransactionBatchDTO dto.CreateTransactionBatchDTO
+	if err := c.ShouldBindJSON(&createTransactionBatchDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := createTransactionBatchDTO.CheckCreateTransactionBatchDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, batch, err := t.transactionService.CreateBatch(
+		createTransactionBatchDTO.ToModels(),
+		createTransactionBatchDTO.LineErrors(),
+		createTransactionBatchDTO.Mode,
+		ctx,
+	)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"batch": batch,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (t *transactionHandler) TransferTransaction(c *gin.Context) {
+	ctx := c.Request.Context()
+	var transferTransactionDTO dto.TransferTransactionDTO
+	if err := c.ShouldBindJSON(&transferTransactionDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := transferTransactionDTO.CheckTransferTransactionDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, transactions, err := t.transactionService.Transfer(
+		transferTransactionDTO.SourceWarehouseID,
+		transferTransactionDTO.DestinationWarehouseID,
+		transferTransactionDTO.ProductID,
+		transferTransactionDTO.Quantity,
+		transferTransactionDTO.Serials,
+		ctx,
+	)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"reference":    transactions[0].Reference,
+		"transactions": transactions,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 재고내역 취소 ( 삭제 대신 반대 방향의 재고내역 생성 )
+func (t *transactionHandler) ReverseTransaction(c *gin.Context) {
 	ctx := c.Request.Context()
 	id := c.Param("id")
 	if id == "" {
@@ -112,11 +189,15 @@ func (t *transactionHandler) DeleteTransaction(c *gin.Context) {
 		return
 	}
 
-	status, err := t.transactionService.Delete(uint(id_int), ctx)
+	status, transactions, err := t.transactionService.Reverse(uint(id_int), ctx)
 	if err != nil {
 		utils.JSONResponse(c, status, nil, err)
 		return
 	}
 
-	utils.JSONResponse(c, status, nil, nil)
+	res_data := gin.H{
+		"transactions": transactions,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
 }
@@ -12,9 +12,14 @@ import (
 
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 )
 
 func setupTransaction() (*gorm.DB, *gin.Engine, repositories.InventoryRepository, repositories.TransactionRepository, services.TransactionService, handlers.TransactionHandler) {
@@ -22,7 +27,12 @@ func setupTransaction() (*gorm.DB, *gin.Engine, repositories.InventoryRepository
 	db := SetupTestDB()
 	transactionRepo := repositories.NewTransactionRepository(db)
 	inventoryRepo := repositories.NewInventoryRepository(db)
-	transactionService := services.NewTransactionService(transactionRepo, inventoryRepo)
+	lotRepo := repositories.NewLotRepository(db)
+	serialNumberRepo := repositories.NewSerialNumberRepository(db)
+	binLocationRepo := repositories.NewBinLocationRepository(db)
+	stockAlertRepo := repositories.NewStockAlertRepository(db)
+	costLayerRepo := repositories.NewCostLayerRepository(db)
+	transactionService := services.NewTransactionService(transactionRepo, inventoryRepo, repositories.NewWarehouseRepository(db), lotRepo, serialNumberRepo, binLocationRepo, stockAlertRepo, costLayerRepo)
 	transactionHandler := handlers.NewTransactionHandler(transactionService)
 
 	router := gin.Default()
@@ -132,6 +142,132 @@ func TestGetAllTransactions(t *testing.T) {
 	}
 }
 
+func TestGetAllTransactionsPagination(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, _, transactionHandler := setupTransaction()
+	router.GET("/transactions", transactionHandler.GetAllTransactions)
+
+	cleanupTransaction(db)
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+	for _, quantity := range []int{5, 3, 5, 1, 3, 5, 2} {
+		CreateTestTransaction(db, 1, "IN", quantity)
+	}
+
+	type pageResponse struct {
+		Response
+		Data struct {
+			Transactions []models.Transaction `json:"transactions"`
+			Pagination   models.Pagination    `json:"pagination"`
+		} `json:"data"`
+	}
+	getPage := func(path string) pageResponse {
+		rr := sendJSON(router, t, "GET", path, nil)
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+		}
+
+		var resp pageResponse
+		if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		return resp
+	}
+
+	// 페이지 조건을 생략해도 기본 페이지 크기 이내면 모두 조회
+	resp := getPage("/transactions?sort=quantity")
+	if len(resp.Data.Transactions) != 7 || resp.Data.Pagination.Total != 7 || resp.Data.Pagination.Size != models.DEFAULT_PAGE_SIZE || resp.Data.Pagination.NextCursor != "" {
+		t.Errorf("Expected all 7 transactions in a default page, got %d (%+v)", len(resp.Data.Transactions), resp.Data.Pagination)
+	}
+
+	// page/size 방식
+	resp = getPage("/transactions?page=3&size=3")
+	if resp.Data.Pagination.Total != 7 {
+		t.Errorf("Expected total 7, got %d", resp.Data.Pagination.Total)
+	}
+	if len(resp.Data.Transactions) != 1 || resp.Data.Transactions[0].ID != 7 {
+		t.Errorf("Expected only transaction 7 on page 3, got %+v", resp.Data.Transactions)
+	}
+	if resp.Data.Pagination.NextCursor != "" {
+		t.Errorf("Expected no next cursor on last page, got %s", resp.Data.Pagination.NextCursor)
+	}
+
+	// cursor 방식 ( 수량 내림차순, 같은 수량은 ID 내림차순 )
+	seen := make(map[uint]bool)
+	var quantities []int
+	path := "/transactions?size=3&sort=quantity&order=desc"
+	for pages := 0; path != ""; pages++ {
+		if pages > 3 {
+			t.Fatalf("Expected cursor to end within 3 pages")
+		}
+
+		resp := getPage(path)
+		for _, transaction := range resp.Data.Transactions {
+			if seen[transaction.ID] {
+				t.Errorf("Transaction %d returned twice", transaction.ID)
+			}
+			seen[transaction.ID] = true
+			quantities = append(quantities, transaction.Quantity)
+		}
+
+		path = ""
+		if resp.Data.Pagination.NextCursor != "" {
+			path = "/transactions?size=3&sort=quantity&order=desc&cursor=" + resp.Data.Pagination.NextCursor
+		}
+	}
+	if len(seen) != 7 {
+		t.Fatalf("Expected 7 transactions across pages, got %d", len(seen))
+	}
+	for i := 1; i < len(quantities); i++ {
+		if quantities[i] > quantities[i-1] {
+			t.Errorf("Expected descending quantities, got %v", quantities)
+			break
+		}
+	}
+
+	// 시간 컬럼 정렬 cursor ( 마지막 레코드의 생성 시각부터 이어서 조회 )
+	seen = make(map[uint]bool)
+	path = "/transactions?size=3&sort=created_at"
+	for pages := 0; path != ""; pages++ {
+		if pages > 3 {
+			t.Fatalf("Expected cursor to end within 3 pages")
+		}
+
+		resp := getPage(path)
+		for _, transaction := range resp.Data.Transactions {
+			if seen[transaction.ID] {
+				t.Errorf("Transaction %d returned twice", transaction.ID)
+			}
+			seen[transaction.ID] = true
+		}
+
+		path = ""
+		if resp.Data.Pagination.NextCursor != "" {
+			path = "/transactions?size=3&sort=created_at&cursor=" + resp.Data.Pagination.NextCursor
+		}
+	}
+	if len(seen) != 7 {
+		t.Fatalf("Expected 7 transactions across pages sorted by created_at, got %d", len(seen))
+	}
+
+	// 허용되지 않은 정렬 컬럼, 범위를 벗어난 size, 잘못된 cursor, cursor 와 다른 정렬 조건
+	cursor := getPage("/transactions?size=3&sort=quantity&order=desc").Data.Pagination.NextCursor
+	for _, path := range []string{
+		"/transactions?sort=inventory_id",
+		"/transactions?size=501",
+		"/transactions?order=up",
+		"/transactions?cursor=invalid",
+		"/transactions?size=3&sort=quantity&cursor=" + cursor,
+		"/transactions?size=3&order=desc&cursor=" + cursor,
+	} {
+		rr := sendJSON(router, t, "GET", path, nil)
+		if rr.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status code %d, got %d", path, http.StatusBadRequest, rr.Code)
+		}
+	}
+}
+
 func TestGetTransaction(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	db, router, _, _, _, transactionHandler := setupTransaction()
@@ -175,10 +311,10 @@ func TestGetTransaction(t *testing.T) {
 	}
 }
 
-func TestDeleteTransaction(t *testing.T) {
+func TestReverseTransaction(t *testing.T) {
 	gin.SetMode(gin.TestMode)
-	db, router, _, _, _, transactionHandler := setupTransaction()
-	router.DELETE("/transactions/:id", transactionHandler.DeleteTransaction)
+	db, router, inventoryRepo, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions/:id/reverse", transactionHandler.ReverseTransaction)
 
 	cleanupTransaction(db)
 	CreateTestProduct(db, "TestProduct", "TestSKU")
@@ -186,26 +322,158 @@ func TestDeleteTransaction(t *testing.T) {
 	CreateTestInventory(db, 1, 1, 10)
 	CreateTestTransaction(db, 1, "IN", 10)
 
-	req, err := http.NewRequest("DELETE", "/transactions/1", nil)
+	for _, tc := range []struct {
+		path     string
+		expected int
+	}{
+		{"/transactions/1/reverse", http.StatusCreated},
+		{"/transactions/1/reverse", http.StatusConflict}, // 이미 취소됨
+		{"/transactions/2/reverse", http.StatusConflict}, // 취소로 생성된 재고내역
+		{"/transactions/999/reverse", http.StatusNotFound},
+	} {
+		req, err := http.NewRequest("POST", tc.path, nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d for %s, got %d", tc.expected, tc.path, rr.Code)
+		}
+	}
+
+	// 원본은 남아 있고 반대 방향 재고내역이 추가됨
+	var transactions []models.Transaction
+	if err := db.Order("id").Find(&transactions).Error; err != nil {
+		t.Fatalf("Failed to find transactions: %v", err)
+	}
+	if len(transactions) != 2 {
+		t.Fatalf("Expected 2 transactions, got %d", len(transactions))
+	}
+	if transactions[0].ReversedAt == nil {
+		t.Errorf("Expected original transaction to be marked reversed")
+	}
+	if transactions[1].Type != "OUT" || transactions[1].Quantity != 10 || transactions[1].ReversalOfID == nil || *transactions[1].ReversalOfID != 1 {
+		t.Errorf("Expected OUT 10 linked to transaction 1, got %+v", transactions[1])
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
 	if err != nil {
-		t.Fatalf("Failed to create request: %v", err)
+		t.Fatalf("Failed to find inventory: %v", err)
 	}
-	req.Header.Set("Content-Type", "application/json")
+	if inventory.Quantity != 0 {
+		t.Errorf("Expected inventory quantity to be 0, got %d", inventory.Quantity)
+	}
+}
 
-	rr := httptest.NewRecorder()
-	router.ServeHTTP(rr, req)
+func TestReverseAdjustTransaction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+	router.POST("/transactions/:id/reverse", transactionHandler.ReverseTransaction)
 
-	if rr.Code != http.StatusOK {
-		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	cleanupTransaction(db)
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+
+	// 10 → 4 로 조정 후 입고 3 → 7, 조정 취소는 변경량(-6)만 되돌려 13
+	for _, payload := range []dto.CreateTransactionDTO{
+		{InventoryID: 1, Type: "ADJUST", Quantity: 4},
+		{InventoryID: 1, Type: "IN", Quantity: 3},
+	} {
+		if rr := postTransferOrder(router, t, "/transactions", payload); rr.Code != http.StatusCreated {
+			t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+		}
 	}
 
-	var transactionCount int64
-	if err := db.Model(&models.Transaction{}).Count(&transactionCount).Error; err != nil {
-		t.Fatalf("Failed to count transactions: %v", err)
+	rr := postTransferOrder(router, t, "/transactions/1/reverse", nil)
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
 	}
 
-	if transactionCount != 0 {
-		t.Errorf("Expected transaction count to be 0, got %d", transactionCount)
+	var resp struct {
+		Response
+		Data struct {
+			Transactions []models.Transaction `json:"transactions"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if len(resp.Data.Transactions) != 1 || resp.Data.Transactions[0].Type != "IN" || resp.Data.Transactions[0].Quantity != 6 {
+		t.Fatalf("Expected IN 6 reversal, got %+v", resp.Data.Transactions)
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+	if inventory.Quantity != 13 {
+		t.Errorf("Expected inventory quantity to be 13, got %d", inventory.Quantity)
+	}
+}
+
+func TestReverseLotTrackedTransaction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+	router.POST("/transactions/:id/reverse", transactionHandler.ReverseTransaction)
+
+	cleanupTransaction(db)
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Model(product).Update("lot_tracked", true)
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 0)
+
+	lateExpiry := time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC)
+	earlyExpiry := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	for _, payload := range []dto.CreateTransactionDTO{
+		{InventoryID: 1, Quantity: 5, Type: "IN", LotNumber: "LOT-EARLY", ExpiresAt: &earlyExpiry},
+		{InventoryID: 1, Quantity: 5, Type: "IN", LotNumber: "LOT-LATE", ExpiresAt: &lateExpiry},
+		{InventoryID: 1, Quantity: 7, Type: "OUT"},
+	} {
+		if rr := postTransferOrder(router, t, "/transactions", payload); rr.Code != http.StatusCreated {
+			t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+		}
+	}
+
+	// 출고 취소: 소진된 로트별로 재입고 ( LOT-EARLY 5, LOT-LATE 2 )
+	if rr := postTransferOrder(router, t, "/transactions/3/reverse", nil); rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	// 입고 취소: 유통기한 순서와 관계없이 입고한 로트(LOT-LATE)에서 차감
+	if rr := postTransferOrder(router, t, "/transactions/2/reverse", nil); rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	var lots []models.Lot
+	if err := db.Order("lot_number").Find(&lots).Error; err != nil {
+		t.Fatalf("Failed to find lots: %v", err)
+	}
+	if len(lots) != 2 || lots[0].LotNumber != "LOT-EARLY" || lots[0].Quantity != 5 || lots[1].Quantity != 0 {
+		t.Fatalf("Expected LOT-EARLY 5 and LOT-LATE 0, got %+v", lots)
+	}
+
+	// 감소 조정 취소: 조정으로 소진된 로트(LOT-EARLY)에 재입고
+	if rr := postTransferOrder(router, t, "/transactions", dto.CreateTransactionDTO{InventoryID: 1, Quantity: 2, Type: "ADJUST", LotNumber: "LOT-EARLY"}); rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+	var adjust models.Transaction
+	db.Where("type = ?", "ADJUST").First(&adjust)
+	if rr := postTransferOrder(router, t, fmt.Sprintf("/transactions/%d/reverse", adjust.ID), nil); rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	if err := db.Order("lot_number").Find(&lots).Error; err != nil {
+		t.Fatalf("Failed to find lots: %v", err)
+	}
+	if lots[0].Quantity != 5 || lots[1].Quantity != 0 {
+		t.Errorf("Expected LOT-EARLY 5 and LOT-LATE 0 after adjust reversal, got %+v", lots)
 	}
 }
 
@@ -334,3 +602,866 @@ func TestAdjustTransaction(t *testing.T) {
 		t.Errorf("Expected inventory quantity to be 10, got %d", inventory.Quantity)
 	}
 }
+
+func TestTransferTransaction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions/transfer", transactionHandler.TransferTransaction)
+	router.GET("/transactions", transactionHandler.GetAllTransactions)
+	payload := dto.TransferTransactionDTO{
+		SourceWarehouseID:      1,
+		DestinationWarehouseID: 2,
+		ProductID:              1,
+		Quantity:               4,
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	cleanupTransaction(db)
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	CreateTestInventory(db, 1, 1, 10)
+
+	req, err := http.NewRequest("POST", "/transactions/transfer", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			Reference    string               `json:"reference"`
+			Transactions []models.Transaction `json:"transactions"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if resp.Data.Reference == "" {
+		t.Fatalf("Expected transfer reference to be returned")
+	}
+
+	source, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+	if source.Quantity != 6 {
+		t.Errorf("Expected source quantity to be 6, got %d", source.Quantity)
+	}
+
+	destination, err := inventoryRepo.FindByWarehouseAndProduct(2, 1)
+	if err != nil {
+		t.Fatalf("Failed to find destination inventory: %v", err)
+	}
+	if destination.Quantity != 4 {
+		t.Errorf("Expected destination quantity to be 4, got %d", destination.Quantity)
+	}
+
+	req, err = http.NewRequest("GET", "/transactions?reference="+resp.Data.Reference, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	var listResp struct {
+		Response
+		Data struct {
+			Transactions []models.Transaction `json:"transactions"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &listResp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if len(listResp.Data.Transactions) != 2 {
+		t.Errorf("Expected 2 linked transactions, got %d", len(listResp.Data.Transactions))
+	}
+}
+
+func TestTransferTransactionWithoutSourceInventory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions/transfer", transactionHandler.TransferTransaction)
+	payload := dto.TransferTransactionDTO{
+		SourceWarehouseID:      1,
+		DestinationWarehouseID: 2,
+		ProductID:              1,
+		Quantity:               4,
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	cleanupTransaction(db)
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+
+	req, err := http.NewRequest("POST", "/transactions/transfer", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+
+	var inventoryCount int64
+	if err := db.Model(&models.Inventory{}).Count(&inventoryCount).Error; err != nil {
+		t.Fatalf("Failed to count inventories: %v", err)
+	}
+	if inventoryCount != 0 {
+		t.Errorf("Expected no inventory to be created, got %d", inventoryCount)
+	}
+}
+
+func TestTransferTransactionWithoutDestinationWarehouse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions/transfer", transactionHandler.TransferTransaction)
+
+	cleanupTransaction(db)
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+
+	rr := sendJSON(router, t, "POST", "/transactions/transfer", dto.TransferTransactionDTO{
+		SourceWarehouseID:      1,
+		DestinationWarehouseID: 999,
+		ProductID:              1,
+		Quantity:               4,
+	})
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusNotFound, rr.Code, rr.Body.String())
+	}
+
+	// 출발 창고 재고는 그대로이고 도착 창고 재고도 생성되지 않아야 함
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+	if inventory.Quantity != 10 {
+		t.Errorf("Expected source quantity to be 10, got %d", inventory.Quantity)
+	}
+
+	var inventoryCount int64
+	if err := db.Model(&models.Inventory{}).Count(&inventoryCount).Error; err != nil {
+		t.Fatalf("Failed to count inventories: %v", err)
+	}
+	if inventoryCount != 1 {
+		t.Errorf("Expected only the source inventory, got %d", inventoryCount)
+	}
+}
+
+func TestOutTransactionInsufficientStock(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+	payload := dto.CreateTransactionDTO{
+		InventoryID: 1,
+		Quantity:    6,
+		Type:        "OUT",
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	cleanupTransaction(db)
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 5)
+
+	req, err := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+
+	if inventory.Quantity != 5 {
+		t.Errorf("Expected inventory quantity to be 5, got %d", inventory.Quantity)
+	}
+
+	var transactionCount int64
+	if err := db.Model(&models.Transaction{}).Count(&transactionCount).Error; err != nil {
+		t.Fatalf("Failed to count transactions: %v", err)
+	}
+
+	if transactionCount != 0 {
+		t.Errorf("Expected transaction count to be 0, got %d", transactionCount)
+	}
+}
+
+func TestOutTransactionNegativeStockWarning(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+	payload := dto.CreateTransactionDTO{
+		InventoryID: 1,
+		Quantity:    6,
+		Type:        "OUT",
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	cleanupTransaction(db)
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	warehouse, _ := CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	db.Model(warehouse).Update("negative_stock_policy", models.NEGATIVE_STOCK_WARN)
+	CreateTestInventory(db, 1, 1, 5)
+
+	req, err := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			Transaction *models.Transaction `json:"transaction"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if resp.Data.Transaction == nil || resp.Data.Transaction.Warning == "" {
+		t.Errorf("Expected negative stock warning to be returned")
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+
+	if inventory.Quantity != -1 {
+		t.Errorf("Expected inventory quantity to be -1, got %d", inventory.Quantity)
+	}
+}
+
+func TestLotTrackedInTransactionRequiresLot(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+	payload := dto.CreateTransactionDTO{
+		InventoryID: 1,
+		Quantity:    5,
+		Type:        "IN",
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	cleanupTransaction(db)
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Model(product).Update("lot_tracked", true)
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 0)
+
+	req, err := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+}
+
+func TestLotTrackedOutTransactionFEFO(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+
+	cleanupTransaction(db)
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Model(product).Update("lot_tracked", true)
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 0)
+
+	lateExpiry := time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC)
+	earlyExpiry := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	for _, payload := range []dto.CreateTransactionDTO{
+		{InventoryID: 1, Quantity: 5, Type: "IN", LotNumber: "LOT-LATE", ExpiresAt: &lateExpiry},
+		{InventoryID: 1, Quantity: 5, Type: "IN", LotNumber: "LOT-EARLY", ExpiresAt: &earlyExpiry},
+	} {
+		jsonPayload, err := json.Marshal(payload)
+		if err != nil {
+			t.Fatalf("Failed to marshal JSON payload: %v", err)
+		}
+
+		req, err := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusCreated {
+			t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+		}
+	}
+
+	jsonPayload, err := json.Marshal(dto.CreateTransactionDTO{InventoryID: 1, Quantity: 7, Type: "OUT"})
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	req, err := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			Transaction *models.Transaction `json:"transaction"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if len(resp.Data.Transaction.Lots) != 2 {
+		t.Fatalf("Expected 2 consumed lots, got %d", len(resp.Data.Transaction.Lots))
+	}
+
+	if resp.Data.Transaction.Lots[0].Lot.LotNumber != "LOT-EARLY" || resp.Data.Transaction.Lots[0].Quantity != 5 {
+		t.Errorf("Expected LOT-EARLY to be consumed first, got %+v", resp.Data.Transaction.Lots[0])
+	}
+
+	if resp.Data.Transaction.Lots[1].Lot.LotNumber != "LOT-LATE" || resp.Data.Transaction.Lots[1].Quantity != 2 {
+		t.Errorf("Expected 2 from LOT-LATE, got %+v", resp.Data.Transaction.Lots[1])
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+
+	if inventory.Quantity != 3 {
+		t.Errorf("Expected inventory quantity to be 3, got %d", inventory.Quantity)
+	}
+
+	if len(inventory.Lots) != 2 || inventory.Lots[1].Quantity != 3 {
+		t.Errorf("Expected LOT-LATE to have 3 left, got %+v", inventory.Lots)
+	}
+}
+
+func TestLotTrackedAdjustTransaction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+
+	cleanupTransaction(db)
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Model(product).Update("lot_tracked", true)
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 0)
+
+	if rr := postTransferOrder(router, t, "/transactions", dto.CreateTransactionDTO{InventoryID: 1, Quantity: 5, Type: "IN", LotNumber: "LOT-A"}); rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	// 로트 번호 없는 조정은 불가
+	if rr := postTransferOrder(router, t, "/transactions", dto.CreateTransactionDTO{InventoryID: 1, Quantity: 8, Type: "ADJUST"}); rr.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+
+	// 증가 조정은 지정 로트에 입고, 감소 조정은 지정 로트에서 소진
+	for _, payload := range []dto.CreateTransactionDTO{
+		{InventoryID: 1, Quantity: 8, Type: "ADJUST", LotNumber: "LOT-B"},
+		{InventoryID: 1, Quantity: 6, Type: "ADJUST", LotNumber: "LOT-A"},
+	} {
+		if rr := postTransferOrder(router, t, "/transactions", payload); rr.Code != http.StatusCreated {
+			t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+		}
+	}
+
+	// 지정 로트 수량보다 많이 감소 조정 불가
+	if rr := postTransferOrder(router, t, "/transactions", dto.CreateTransactionDTO{InventoryID: 1, Quantity: 1, Type: "ADJUST", LotNumber: "LOT-A"}); rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+	if inventory.Quantity != 6 {
+		t.Errorf("Expected inventory quantity to be 6, got %d", inventory.Quantity)
+	}
+
+	var lots []models.Lot
+	if err := db.Order("lot_number").Find(&lots).Error; err != nil {
+		t.Fatalf("Failed to find lots: %v", err)
+	}
+	if len(lots) != 2 || lots[0].Quantity != 3 || lots[1].Quantity != 3 {
+		t.Errorf("Expected LOT-A 3 and LOT-B 3, got %+v", lots)
+	}
+}
+
+func TestCreateTransactionStockAlert(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+
+	cleanupTransaction(db)
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	inventory, _ := CreateTestInventory(db, 1, 1, 10)
+	db.Model(inventory).Updates(map[string]interface{}{"min_quantity": 2, "reorder_point": 5})
+
+	// 재주문점을 넘어선 첫 출고에만 알림 발생
+	for i, expected := range []string{models.STOCK_ALERT_REORDER, "", models.STOCK_ALERT_BELOW_MIN} {
+		jsonPayload, err := json.Marshal(dto.CreateTransactionDTO{InventoryID: 1, Quantity: []int{6, 1, 2}[i], Type: "OUT"})
+		if err != nil {
+			t.Fatalf("Failed to marshal JSON payload: %v", err)
+		}
+
+		req, err := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusCreated {
+			t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+		}
+
+		var resp struct {
+			Response
+			Data struct {
+				Transaction *models.Transaction `json:"transaction"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+
+		level := ""
+		if resp.Data.Transaction.StockAlert != nil {
+			level = resp.Data.Transaction.StockAlert.Level
+		}
+		if level != expected {
+			t.Errorf("Expected stock alert %q, got %q", expected, level)
+		}
+	}
+
+	var count int64
+	db.Model(&models.StockAlert{}).Count(&count)
+	if count != 2 {
+		t.Errorf("Expected 2 stored stock alerts, got %d", count)
+	}
+}
+
+func TestOutTransactionCostOfGoods(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// 10개 x 5 + 10개 x 7 입고 후 15개 출고
+	for _, tc := range []struct {
+		method      string
+		costOfGoods float64
+		averageCost float64
+	}{
+		{models.COST_METHOD_FIFO, 10*5 + 5*7, 7},
+		{models.COST_METHOD_AVERAGE, 15 * 6, 6},
+	} {
+		db, router, inventoryRepo, _, _, transactionHandler := setupTransaction()
+		router.POST("/transactions", transactionHandler.CreateTransaction)
+
+		product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+		db.Model(product).Update("cost_method", tc.method)
+		CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+		CreateTestInventory(db, 1, 1, 0)
+
+		var out *models.Transaction
+		for _, payload := range []dto.CreateTransactionDTO{
+			{InventoryID: 1, Quantity: 10, Type: "IN", UnitCost: 5},
+			{InventoryID: 1, Quantity: 10, Type: "IN", UnitCost: 7},
+			{InventoryID: 1, Quantity: 15, Type: "OUT"},
+		} {
+			jsonPayload, err := json.Marshal(payload)
+			if err != nil {
+				t.Fatalf("Failed to marshal JSON payload: %v", err)
+			}
+
+			req, err := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			if rr.Code != http.StatusCreated {
+				t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+			}
+
+			var resp struct {
+				Response
+				Data struct {
+					Transaction *models.Transaction `json:"transaction"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+			out = resp.Data.Transaction
+		}
+
+		if out.CostOfGoods != tc.costOfGoods {
+			t.Errorf("%s: expected cost of goods %.2f, got %.2f", tc.method, tc.costOfGoods, out.CostOfGoods)
+		}
+
+		var stored models.Transaction
+		db.First(&stored, out.ID)
+		if stored.CostOfGoods != tc.costOfGoods {
+			t.Errorf("%s: expected stored cost of goods %.2f, got %.2f", tc.method, tc.costOfGoods, stored.CostOfGoods)
+		}
+
+		inventory, err := inventoryRepo.FindByID(1)
+		if err != nil {
+			t.Fatalf("Failed to find inventory: %v", err)
+		}
+
+		if inventory.Quantity != 5 || inventory.AverageCost != tc.averageCost {
+			t.Errorf("%s: expected 5 units at %.2f, got %d at %.2f", tc.method, tc.averageCost, inventory.Quantity, inventory.AverageCost)
+		}
+	}
+}
+
+func TestCreateTransactionWithUnit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Create(&models.ProductUnit{ProductID: product.ID, Name: models.UNIT_CASE, Factor: 12})
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 0)
+
+	for _, tc := range []struct {
+		payload  dto.CreateTransactionDTO
+		expected int
+	}{
+		{dto.CreateTransactionDTO{InventoryID: 1, Quantity: 2, Type: "IN", Unit: models.UNIT_PALLET}, http.StatusBadRequest},
+		{dto.CreateTransactionDTO{InventoryID: 1, Quantity: 2, Type: "IN", Unit: models.UNIT_CASE, UnitCost: 120}, http.StatusCreated},
+		{dto.CreateTransactionDTO{InventoryID: 1, Quantity: 5, Type: "OUT", Unit: models.UNIT_EACH}, http.StatusCreated},
+	} {
+		jsonPayload, err := json.Marshal(tc.payload)
+		if err != nil {
+			t.Fatalf("Failed to marshal JSON payload: %v", err)
+		}
+
+		req, err := http.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d, got %d", tc.expected, rr.Code)
+		}
+	}
+
+	var in models.Transaction
+	db.Where("type = ?", "IN").First(&in)
+	if in.Quantity != 24 || in.Unit != models.UNIT_CASE || in.UnitQuantity != 2 || in.UnitCost != 10 {
+		t.Errorf("Expected 2 CASE stored as 24 at 10, got %d (%d %s) at %.2f", in.Quantity, in.UnitQuantity, in.Unit, in.UnitCost)
+	}
+
+	inventory, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+
+	if inventory.Quantity != 19 {
+		t.Errorf("Expected inventory quantity to be 19, got %d", inventory.Quantity)
+	}
+}
+
+func TestConcurrentTransactions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db := SetupTestFileDB(filepath.Join(t.TempDir(), "concurrency.db"))
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	transactionService := services.NewTransactionService(
+		repositories.NewTransactionRepository(db),
+		inventoryRepo,
+		repositories.NewWarehouseRepository(db),
+		repositories.NewLotRepository(db),
+		repositories.NewSerialNumberRepository(db),
+		repositories.NewBinLocationRepository(db),
+		repositories.NewStockAlertRepository(db),
+		repositories.NewCostLayerRepository(db),
+	)
+	transactionHandler := handlers.NewTransactionHandler(transactionService)
+
+	router := gin.New()
+	router.POST("/transactions", transactionHandler.CreateTransaction)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 1000)
+	CreateTestInventory(db, 1, 1, 50)
+
+	// 두 재고에 입고/출고를 동시에 요청 ( 재고 1: 출고 3 x 100, 입고 2 x 100 / 재고 2: 출고 1 x 100 )
+	var payloads []dto.CreateTransactionDTO
+	for i := 0; i < 100; i++ {
+		payloads = append(payloads,
+			dto.CreateTransactionDTO{InventoryID: 1, Type: "OUT", Quantity: 3},
+			dto.CreateTransactionDTO{InventoryID: 1, Type: "IN", Quantity: 2},
+			dto.CreateTransactionDTO{InventoryID: 2, Type: "OUT", Quantity: 1},
+		)
+	}
+
+	codes := make([]int, len(payloads))
+	var wg sync.WaitGroup
+	for i, payload := range payloads {
+		wg.Add(1)
+		go func(i int, payload dto.CreateTransactionDTO) {
+			defer wg.Done()
+
+			jsonPayload, _ := json.Marshal(payload)
+			req := httptest.NewRequest("POST", "/transactions", bytes.NewBuffer(jsonPayload))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+			codes[i] = rr.Code
+		}(i, payload)
+	}
+	wg.Wait()
+
+	created := make(map[uint]int)
+	for i, code := range codes {
+		switch code {
+		case http.StatusCreated:
+			created[payloads[i].InventoryID]++
+		case http.StatusConflict:
+			if payloads[i].InventoryID != 2 {
+				t.Fatalf("Unexpected conflict for %+v", payloads[i])
+			}
+		default:
+			t.Fatalf("Unexpected status code %d for %+v", code, payloads[i])
+		}
+	}
+
+	// 재고 2 는 보유 수량 50 만큼만 출고 ( 초과 출고 없음 )
+	for _, tc := range []struct {
+		inventoryID uint
+		created     int
+		quantity    int
+	}{
+		{1, 200, 1000 - 300 + 200},
+		{2, 50, 0},
+	} {
+		if created[tc.inventoryID] != tc.created {
+			t.Errorf("Expected %d transactions for inventory %d, got %d", tc.created, tc.inventoryID, created[tc.inventoryID])
+		}
+
+		inventory, err := inventoryRepo.FindByID(tc.inventoryID)
+		if err != nil {
+			t.Fatalf("Failed to find inventory: %v", err)
+		}
+		if inventory.Quantity != tc.quantity || inventory.Version != tc.created {
+			t.Errorf("Expected inventory %d quantity %d (version %d), got %d (version %d)", tc.inventoryID, tc.quantity, tc.created, inventory.Quantity, inventory.Version)
+		}
+	}
+
+	// 조회 이후 다른 요청이 변경한 재고는 수량 변경 거부
+	if err := inventoryRepo.UpdateQuantity(1, 0, 0); !errors.Is(err, repositories.ErrInventoryVersionConflict) {
+		t.Errorf("Expected version conflict, got %v", err)
+	}
+}
+
+func TestCreateTransactionBatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, _, transactionHandler := setupTransaction()
+	router.POST("/transactions/batch", transactionHandler.CreateTransactionBatch)
+
+	cleanupTransaction(db)
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+
+	type batchResponse struct {
+		Response
+		Data struct {
+			Batch models.TransactionBatchResult `json:"batch"`
+		} `json:"data"`
+	}
+
+	for _, tc := range []struct {
+		name      string
+		payload   dto.CreateTransactionBatchDTO
+		expected  int
+		succeeded int
+		failed    int
+		quantity  int
+	}{
+		// 전체 반영
+		{"atomic", dto.CreateTransactionBatchDTO{Transactions: []dto.CreateTransactionDTO{
+			{InventoryID: 1, Type: "IN", Quantity: 5},
+			{InventoryID: 1, Type: "OUT", Quantity: 3},
+		}}, http.StatusCreated, 2, 0, 12},
+		// 재고 부족 항목이 있으면 전체 취소
+		{"atomic rollback", dto.CreateTransactionBatchDTO{Transactions: []dto.CreateTransactionDTO{
+			{InventoryID: 1, Type: "IN", Quantity: 5},
+			{InventoryID: 1, Type: "OUT", Quantity: 100},
+		}}, http.StatusConflict, 0, 0, 12},
+		// 입력값 오류 항목이 있으면 처리하지 않음
+		{"atomic invalid", dto.CreateTransactionBatchDTO{Transactions: []dto.CreateTransactionDTO{
+			{InventoryID: 1, Type: "IN", Quantity: 5},
+			{InventoryID: 1, Type: "IN"},
+		}}, http.StatusBadRequest, 0, 0, 12},
+		// 실패한 항목만 제외하고 반영
+		{"best effort", dto.CreateTransactionBatchDTO{Mode: models.BATCH_MODE_BEST_EFFORT, Transactions: []dto.CreateTransactionDTO{
+			{InventoryID: 1, Type: "IN", Quantity: 5},
+			{InventoryID: 1, Type: "OUT", Quantity: 100},
+			{InventoryID: 999, Type: "IN", Quantity: 1},
+			{InventoryID: 1, Type: "IN"},
+			{InventoryID: 1, Type: "OUT", Quantity: 7},
+		}}, http.StatusMultiStatus, 2, 3, 10},
+		{"unknown mode", dto.CreateTransactionBatchDTO{Mode: "PARTIAL", Transactions: []dto.CreateTransactionDTO{
+			{InventoryID: 1, Type: "IN", Quantity: 5},
+		}}, http.StatusBadRequest, 0, 0, 10},
+	} {
+		rr := postTransferOrder(router, t, "/transactions/batch", tc.payload)
+		if rr.Code != tc.expected {
+			t.Fatalf("%s: expected status code %d, got %d: %s", tc.name, tc.expected, rr.Code, rr.Body.String())
+		}
+
+		if rr.Code == http.StatusCreated || rr.Code == http.StatusMultiStatus {
+			var response batchResponse
+			if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+			batch := response.Data.Batch
+			if batch.Succeeded != tc.succeeded || batch.Failed != tc.failed || len(batch.Results) != len(tc.payload.Transactions) {
+				t.Errorf("%s: expected %d succeeded and %d failed, got %+v", tc.name, tc.succeeded, tc.failed, batch)
+			}
+			for _, result := range batch.Results {
+				if result.Transaction != nil && result.Transaction.Reference != batch.Reference {
+					t.Errorf("%s: expected reference %s, got %s", tc.name, batch.Reference, result.Transaction.Reference)
+				}
+			}
+		}
+
+		inventory, err := inventoryRepo.FindByID(1)
+		if err != nil {
+			t.Fatalf("Failed to find inventory: %v", err)
+		}
+		if inventory.Quantity != tc.quantity {
+			t.Errorf("%s: expected inventory quantity %d, got %d", tc.name, tc.quantity, inventory.Quantity)
+		}
+	}
+
+	// 반영된 재고내역만 남음 ( atomic 2건 + best effort 2건 )
+	var count int64
+	db.Model(&models.Transaction{}).Count(&count)
+	if count != 4 {
+		t.Errorf("Expected 4 transactions, got %d", count)
+	}
+}
+
+func TestGetAllTransactionsDefaultPageSize(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, _, transactionHandler := setupTransaction()
+	router.GET("/transactions", transactionHandler.GetAllTransactions)
+
+	cleanupTransaction(db)
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestInventory(db, 1, 1, 10)
+	total := models.DEFAULT_PAGE_SIZE + 5
+	for i := 0; i < total; i++ {
+		CreateTestTransaction(db, 1, "IN", 1)
+	}
+
+	// 아무 조건 없이 조회해도 기본 페이지 크기까지만 조회
+	rr := sendJSON(router, t, "GET", "/transactions", nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			Transactions []models.Transaction `json:"transactions"`
+			Pagination   models.Pagination    `json:"pagination"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(resp.Data.Transactions) != models.DEFAULT_PAGE_SIZE {
+		t.Errorf("Expected %d transactions, got %d", models.DEFAULT_PAGE_SIZE, len(resp.Data.Transactions))
+	}
+	if resp.Data.Pagination.Total != int64(total) || resp.Data.Pagination.NextCursor == "" {
+		t.Errorf("Expected total %d with a next cursor, got %+v", total, resp.Data.Pagination)
+	}
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type TransferOrderHandler interface {
+	GetAllTransferOrders(c *gin.Context)
+	GetTransferOrder(c *gin.Context)
+	CreateTransferOrder(c *gin.Context)
+	DispatchTransferOrder(c *gin.Context)
+	ReceiveTransferOrder(c *gin.Context)
+	CancelTransferOrder(c *gin.Context)
+}
+
+type transferOrderHandler struct {
+	transferOrderService services.TransferOrderService
+}
+
+func NewTransferOrderHandler(transferOrderService services.TransferOrderService) TransferOrderHandler {
+	return &transferOrderHandler{
+		transferOrderService: transferOrderService,
+	}
+}
+
+func (t *transferOrderHandler) GetAllTransferOrders(c *gin.Context) {
+	search_filter := utils.GetTransferOrderSearchQuery(c)
+
+	status, transferOrders, err := t.transferOrderService.FindAll(search_filter)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"transfer_orders": transferOrders,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (t *transferOrderHandler) GetTransferOrder(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, transferOrder, err := t.transferOrderService.FindByID(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"transfer_order": transferOrder,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (t *transferOrderHandler) CreateTransferOrder(c *gin.Context) {
+	var createTransferOrderDTO dto.CreateTransferOrderDTO
+	if err := c.ShouldBindJSON(&createTransferOrderDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := createTransferOrderDTO.CheckCreateTransferOrderDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, transferOrder, err := t.transferOrderService.Create(createTransferOrderDTO.ToModel(c.GetUint("userID")))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"transfer_order": transferOrder,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (t *transferOrderHandler) DispatchTransferOrder(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	// 일련번호 관리 제품이 없으면 본문 없이 요청 가능
+	var dispatchTransferOrderDTO dto.DispatchTransferOrderDTO
+	if err := c.ShouldBindJSON(&dispatchTransferOrderDTO); err != nil && !errors.Is(err, io.EOF) {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := dispatchTransferOrderDTO.CheckDispatchTransferOrderDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, transferOrder, err := t.transferOrderService.Dispatch(uint(id_int), dispatchTransferOrderDTO.ToSerials(), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"transfer_order": transferOrder,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (t *transferOrderHandler) ReceiveTransferOrder(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var receiveTransferOrderDTO dto.ReceiveTransferOrderDTO
+	if err := c.ShouldBindJSON(&receiveTransferOrderDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	if ok, err := receiveTransferOrderDTO.CheckReceiveTransferOrderDTO(); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, transferOrder, err := t.transferOrderService.Receive(uint(id_int), receiveTransferOrderDTO.ToReceipts(), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"transfer_order": transferOrder,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+func (t *transferOrderHandler) CancelTransferOrder(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, transferOrder, err := t.transferOrderService.Cancel(uint(id_int))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"transfer_order": transferOrder,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
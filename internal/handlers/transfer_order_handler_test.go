@@ -0,0 +1,383 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/dto"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func setupTransferOrder() (*gorm.DB, *gin.Engine, repositories.InventoryRepository, repositories.TransferOrderRepository, handlers.TransferOrderHandler) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	transactionRepo := repositories.NewTransactionRepository(db)
+	transferOrderRepo := repositories.NewTransferOrderRepository(db)
+	lotRepo := repositories.NewLotRepository(db)
+	serialNumberRepo := repositories.NewSerialNumberRepository(db)
+	binLocationRepo := repositories.NewBinLocationRepository(db)
+	stockAlertRepo := repositories.NewStockAlertRepository(db)
+	costLayerRepo := repositories.NewCostLayerRepository(db)
+	transactionService := services.NewTransactionService(transactionRepo, inventoryRepo, repositories.NewWarehouseRepository(db), lotRepo, serialNumberRepo, binLocationRepo, stockAlertRepo, costLayerRepo)
+	transferOrderService := services.NewTransferOrderService(transferOrderRepo, inventoryRepo, transactionRepo, transactionService)
+	transferOrderHandler := handlers.NewTransferOrderHandler(transferOrderService)
+
+	router := gin.Default()
+	return db, router, inventoryRepo, transferOrderRepo, transferOrderHandler
+}
+
+func postTransferOrder(router *gin.Engine, t *testing.T, path string, payload interface{}) *httptest.ResponseRecorder {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON payload: %v", err)
+	}
+
+	req, err := http.NewRequest("POST", path, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestCreateTransferOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, transferOrderHandler := setupTransferOrder()
+	router.POST("/transfer-orders", transferOrderHandler.CreateTransferOrder)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	CreateTestInventory(db, 1, 1, 10)
+
+	rr := postTransferOrder(router, t, "/transfer-orders", dto.CreateTransferOrderDTO{
+		SourceWarehouseID:      1,
+		DestinationWarehouseID: 2,
+		Items: []dto.CreateTransferOrderItemDTO{
+			{ProductID: 1, Quantity: 5},
+		},
+	})
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	var resp struct {
+		Response
+		Data struct {
+			TransferOrder *models.TransferOrder `json:"transfer_order"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if resp.Data.TransferOrder == nil || resp.Data.TransferOrder.ID == 0 {
+		t.Fatalf("Expected transfer order to be returned, got nil")
+	}
+
+	if resp.Data.TransferOrder.Status != models.TRANSFER_STATUS_DRAFT {
+		t.Errorf("Expected status %s, got %s", models.TRANSFER_STATUS_DRAFT, resp.Data.TransferOrder.Status)
+	}
+
+	if resp.Data.TransferOrder.Reference == "" {
+		t.Errorf("Expected reference to be generated")
+	}
+}
+
+func TestDispatchAndReceiveTransferOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, transferOrderRepo, transferOrderHandler := setupTransferOrder()
+	router.POST("/transfer-orders/:id/dispatch", transferOrderHandler.DispatchTransferOrder)
+	router.POST("/transfer-orders/:id/receive", transferOrderHandler.ReceiveTransferOrder)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	CreateTestInventory(db, 1, 1, 10)
+	CreateTestTransferOrder(db, 1, 2, []models.TransferOrderItem{
+		{ProductID: 1, Quantity: 6},
+	})
+
+	rr := postTransferOrder(router, t, "/transfer-orders/1/dispatch", nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	source, err := inventoryRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find inventory: %v", err)
+	}
+	if source.Quantity != 4 {
+		t.Errorf("Expected source quantity to be 4, got %d", source.Quantity)
+	}
+
+	// 이미 출고된 이동 지시는 다시 출고되지 않음
+	rr = postTransferOrder(router, t, "/transfer-orders/1/dispatch", nil)
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+	source, _ = inventoryRepo.FindByID(1)
+	if source.Quantity != 4 {
+		t.Errorf("Expected source quantity to stay 4, got %d", source.Quantity)
+	}
+
+	// 일부 입고
+	rr = postTransferOrder(router, t, "/transfer-orders/1/receive", dto.ReceiveTransferOrderDTO{
+		Items: []dto.ReceiveTransferOrderItemDTO{{ItemID: 1, Quantity: 2}},
+	})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	transferOrder, err := transferOrderRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find transfer order: %v", err)
+	}
+	if transferOrder.Status != models.TRANSFER_STATUS_PARTIALLY_RECEIVED {
+		t.Errorf("Expected status %s, got %s", models.TRANSFER_STATUS_PARTIALLY_RECEIVED, transferOrder.Status)
+	}
+
+	inTransit, err := transferOrderRepo.GetInTransitQuantities()
+	if err != nil {
+		t.Fatalf("Failed to get in-transit quantities: %v", err)
+	}
+	if len(inTransit) != 1 || inTransit[0].Quantity != 4 {
+		t.Errorf("Expected 4 in transit, got %v", inTransit)
+	}
+
+	destination, err := inventoryRepo.FindByWarehouseAndProduct(2, 1)
+	if err != nil {
+		t.Fatalf("Failed to find destination inventory: %v", err)
+	}
+	if destination.Quantity != 2 {
+		t.Errorf("Expected destination quantity to be 2, got %d", destination.Quantity)
+	}
+
+	// 남은 수량 입고
+	rr = postTransferOrder(router, t, "/transfer-orders/1/receive", dto.ReceiveTransferOrderDTO{
+		Items: []dto.ReceiveTransferOrderItemDTO{{ItemID: 1, Quantity: 4}},
+	})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	transferOrder, err = transferOrderRepo.FindByID(1)
+	if err != nil {
+		t.Fatalf("Failed to find transfer order: %v", err)
+	}
+	if transferOrder.Status != models.TRANSFER_STATUS_RECEIVED {
+		t.Errorf("Expected status %s, got %s", models.TRANSFER_STATUS_RECEIVED, transferOrder.Status)
+	}
+
+	destination, err = inventoryRepo.FindByWarehouseAndProduct(2, 1)
+	if err != nil {
+		t.Fatalf("Failed to find destination inventory: %v", err)
+	}
+	if destination.Quantity != 6 {
+		t.Errorf("Expected destination quantity to be 6, got %d", destination.Quantity)
+	}
+}
+
+func TestReceiveTransferOrderOverRemaining(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, transferOrderHandler := setupTransferOrder()
+	router.POST("/transfer-orders/:id/dispatch", transferOrderHandler.DispatchTransferOrder)
+	router.POST("/transfer-orders/:id/receive", transferOrderHandler.ReceiveTransferOrder)
+
+	CreateTestProduct(db, "TestProduct", "TestSKU")
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	CreateTestInventory(db, 1, 1, 10)
+	CreateTestTransferOrder(db, 1, 2, []models.TransferOrderItem{
+		{ProductID: 1, Quantity: 3},
+	})
+
+	// 출고 전 입고 불가
+	rr := postTransferOrder(router, t, "/transfer-orders/1/receive", dto.ReceiveTransferOrderDTO{
+		Items: []dto.ReceiveTransferOrderItemDTO{{ItemID: 1, Quantity: 1}},
+	})
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, rr.Code)
+	}
+
+	postTransferOrder(router, t, "/transfer-orders/1/dispatch", nil)
+
+	rr = postTransferOrder(router, t, "/transfer-orders/1/receive", dto.ReceiveTransferOrderDTO{
+		Items: []dto.ReceiveTransferOrderItemDTO{{ItemID: 1, Quantity: 5}},
+	})
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+}
+
+func TestDispatchAndReceiveSerializedTransferOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, transferOrderHandler := setupTransferOrder()
+	router.POST("/transfer-orders/:id/dispatch", transferOrderHandler.DispatchTransferOrder)
+	router.POST("/transfer-orders/:id/receive", transferOrderHandler.ReceiveTransferOrder)
+
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Model(product).Update("serialized", true)
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	inventory, _ := CreateTestInventory(db, 1, 1, 2)
+	for _, serial := range []string{"SN-1", "SN-2"} {
+		db.Create(&models.SerialNumber{ProductID: product.ID, InventoryID: inventory.ID, Serial: serial, Status: models.SERIAL_STATUS_IN_STOCK})
+	}
+	transferOrder, _ := CreateTestTransferOrder(db, 1, 2, []models.TransferOrderItem{
+		{ProductID: 1, Quantity: 2},
+	})
+	itemID := transferOrder.Items[0].ID
+
+	// 일련번호 없이 출고 불가
+	rr := postTransferOrder(router, t, "/transfer-orders/1/dispatch", nil)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+
+	rr = postTransferOrder(router, t, "/transfer-orders/1/dispatch", dto.DispatchTransferOrderDTO{
+		Items: []dto.DispatchTransferOrderItemDTO{{ItemID: itemID, Serials: []string{"SN-1", "SN-2"}}},
+	})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	rr = postTransferOrder(router, t, "/transfer-orders/1/receive", dto.ReceiveTransferOrderDTO{
+		Items: []dto.ReceiveTransferOrderItemDTO{{ItemID: itemID, Quantity: 2, Serials: []string{"SN-1", "SN-2"}}},
+	})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	destination, err := inventoryRepo.FindByWarehouseAndProduct(2, 1)
+	if err != nil {
+		t.Fatalf("Failed to find destination inventory: %v", err)
+	}
+
+	var received int64
+	db.Model(&models.SerialNumber{}).Where("inventory_id = ? AND status = ?", destination.ID, models.SERIAL_STATUS_IN_STOCK).Count(&received)
+	if received != 2 {
+		t.Errorf("Expected 2 serial numbers in destination, got %d", received)
+	}
+}
+
+func TestReceiveTransferOrderOnlyDispatchedSerials(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, _, _, transferOrderHandler := setupTransferOrder()
+	router.POST("/transfer-orders/:id/dispatch", transferOrderHandler.DispatchTransferOrder)
+	router.POST("/transfer-orders/:id/receive", transferOrderHandler.ReceiveTransferOrder)
+
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Model(product).Update("serialized", true)
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	inventory, _ := CreateTestInventory(db, 1, 1, 3)
+	for _, serial := range []string{"SN-1", "SN-2", "SN-3"} {
+		db.Create(&models.SerialNumber{ProductID: product.ID, InventoryID: inventory.ID, Serial: serial, Status: models.SERIAL_STATUS_IN_STOCK})
+	}
+	transferOrder, _ := CreateTestTransferOrder(db, 1, 2, []models.TransferOrderItem{
+		{ProductID: 1, Quantity: 2},
+	})
+	itemID := transferOrder.Items[0].ID
+
+	rr := postTransferOrder(router, t, "/transfer-orders/1/dispatch", dto.DispatchTransferOrderDTO{
+		Items: []dto.DispatchTransferOrderItemDTO{{ItemID: itemID, Serials: []string{"SN-1", "SN-2"}}},
+	})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	for i, tc := range []struct {
+		serials  []string
+		expected int
+	}{
+		{[]string{"SN-3"}, http.StatusBadRequest}, // 출발 창고에 남아 있는 일련번호
+		{[]string{"SN-9"}, http.StatusBadRequest}, // 존재하지 않는 일련번호
+		{[]string{"SN-1"}, http.StatusOK},
+		{[]string{"SN-1"}, http.StatusBadRequest}, // 이미 입고된 일련번호
+		{[]string{"SN-2"}, http.StatusOK},
+	} {
+		rr := postTransferOrder(router, t, "/transfer-orders/1/receive", dto.ReceiveTransferOrderDTO{
+			Items: []dto.ReceiveTransferOrderItemDTO{{ItemID: itemID, Quantity: 1, Serials: tc.serials}},
+		})
+		if rr.Code != tc.expected {
+			t.Fatalf("Case %d: expected status code %d, got %d: %s", i, tc.expected, rr.Code, rr.Body.String())
+		}
+	}
+
+	var serialNumber models.SerialNumber
+	db.Where("serial = ?", "SN-3").First(&serialNumber)
+	if serialNumber.InventoryID != inventory.ID || serialNumber.Status != models.SERIAL_STATUS_IN_STOCK {
+		t.Errorf("Expected SN-3 to stay in source inventory, got %+v", serialNumber)
+	}
+}
+
+func TestReceiveLotTrackedTransferOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, inventoryRepo, _, transferOrderHandler := setupTransferOrder()
+	router.POST("/transfer-orders/:id/dispatch", transferOrderHandler.DispatchTransferOrder)
+	router.POST("/transfer-orders/:id/receive", transferOrderHandler.ReceiveTransferOrder)
+
+	product, _ := CreateTestProduct(db, "TestProduct", "TestSKU")
+	db.Model(product).Update("lot_tracked", true)
+	CreateTestWarehouse(db, "TestWarehouse", "TestLocation")
+	CreateTestWarehouse(db, "TestWarehouse2", "TestLocation2")
+	CreateTestInventory(db, 1, 1, 10)
+	soon, later := time.Now().AddDate(0, 1, 0).Truncate(time.Second), time.Now().AddDate(0, 6, 0).Truncate(time.Second)
+	db.Create(&models.Lot{InventoryID: 1, LotNumber: "LOT-A", ExpiresAt: &soon, Quantity: 4})
+	db.Create(&models.Lot{InventoryID: 1, LotNumber: "LOT-B", ExpiresAt: &later, Quantity: 6})
+	CreateTestTransferOrder(db, 1, 2, []models.TransferOrderItem{
+		{ProductID: 1, Quantity: 6},
+	})
+
+	// 유통기한이 빠른 순서로 LOT-A 4, LOT-B 2 출고
+	if rr := postTransferOrder(router, t, "/transfer-orders/1/dispatch", nil); rr.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	for i, tc := range []struct {
+		lotNumber string
+		quantity  int
+		expected  int
+	}{
+		{"LOT-C", 1, http.StatusBadRequest}, // 출고되지 않은 로트
+		{"LOT-B", 3, http.StatusBadRequest}, // 출고된 수량보다 많음
+		{"LOT-B", 2, http.StatusOK},
+		{"", 4, http.StatusOK}, // 남은 LOT-A 로 입고
+	} {
+		rr := postTransferOrder(router, t, "/transfer-orders/1/receive", dto.ReceiveTransferOrderDTO{
+			Items: []dto.ReceiveTransferOrderItemDTO{{ItemID: 1, Quantity: tc.quantity, LotNumber: tc.lotNumber}},
+		})
+		if rr.Code != tc.expected {
+			t.Fatalf("Case %d: expected status code %d, got %d: %s", i, tc.expected, rr.Code, rr.Body.String())
+		}
+	}
+
+	destination, err := inventoryRepo.FindByWarehouseAndProduct(2, 1)
+	if err != nil {
+		t.Fatalf("Failed to find destination inventory: %v", err)
+	}
+
+	var lots []models.Lot
+	db.Where("inventory_id = ?", destination.ID).Order("lot_number").Find(&lots)
+	if len(lots) != 2 || lots[0].LotNumber != "LOT-A" || lots[0].Quantity != 4 || lots[1].LotNumber != "LOT-B" || lots[1].Quantity != 2 {
+		t.Fatalf("Expected LOT-A 4 and LOT-B 2 in destination, got %+v", lots)
+	}
+	if lots[0].ExpiresAt == nil || !lots[0].ExpiresAt.Equal(soon) || lots[1].ExpiresAt == nil || !lots[1].ExpiresAt.Equal(later) {
+		t.Errorf("Expected destination lots to keep dispatched expiry dates, got %v / %v", lots[0].ExpiresAt, lots[1].ExpiresAt)
+	}
+}
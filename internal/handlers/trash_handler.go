@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/services"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type TrashHandler interface {
+	GetTrash(c *gin.Context)
+	RestoreTrash(c *gin.Context)
+	PurgeTrash(c *gin.Context)
+}
+
+type trashHandler struct {
+	trashService services.TrashService
+}
+
+func NewTrashHandler(trashService services.TrashService) TrashHandler {
+	return &trashHandler{
+		trashService: trashService,
+	}
+}
+
+// 삭제된 레코드 조회 ( type: warehouses, products, inventories, transactions / 미지정 시 전체 )
+func (h *trashHandler) GetTrash(c *gin.Context) {
+	status, trash, err := h.trashService.FindAll(c.Query("type"))
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"trash": trash,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 삭제된 레코드 복원 ( 함께 삭제된 재고와 재고내역 포함 )
+func (h *trashHandler) RestoreTrash(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, restored, err := h.trashService.Restore(c.Param("type"), uint(id_int), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"restored": restored,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
+
+// 보관 기간(older_than_days, 기본 30일)이 지난 삭제 레코드 영구 삭제
+func (h *trashHandler) PurgeTrash(c *gin.Context) {
+	retentionDays := services.DefaultTrashRetentionDays
+	if days := c.Query("older_than_days"); days != "" {
+		days_int, err := strconv.Atoi(days)
+		if err != nil {
+			utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+			return
+		}
+		retentionDays = days_int
+	}
+
+	status, result, err := h.trashService.Purge(retentionDays)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"purge": result,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
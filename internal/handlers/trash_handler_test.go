@@ -0,0 +1,191 @@
+package handlers_test
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/handlers"
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupTrash() (*gorm.DB, *gin.Engine, repositories.WarehouseRepository, repositories.ProductRepository, repositories.InventoryRepository) {
+	// Test DB 초기화
+	db := SetupTestDB()
+	trashRepo := repositories.NewTrashRepository(db)
+	warehouseRepo := repositories.NewWarehouseRepository(db)
+	productRepo := repositories.NewProductRepository(db)
+	inventoryRepo := repositories.NewInventoryRepository(db)
+	trashService := services.NewTrashService(trashRepo, warehouseRepo, productRepo, inventoryRepo)
+	trashHandler := handlers.NewTrashHandler(trashService)
+
+	router := gin.Default()
+	router.GET("/admin/trash", trashHandler.GetTrash)
+	router.POST("/admin/trash/purge", trashHandler.PurgeTrash)
+	router.POST("/admin/trash/:type/:id/restore", trashHandler.RestoreTrash)
+	return db, router, warehouseRepo, productRepo, inventoryRepo
+}
+
+func requestTrash(router *gin.Engine, t *testing.T, method, path string, expected int) *httptest.ResponseRecorder {
+	req, err := http.NewRequest(method, path, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != expected {
+		t.Fatalf("Expected status code %d for %s %s, got %d: %s", expected, method, path, rr.Code, rr.Body.String())
+	}
+	return rr
+}
+
+func TestTrashRestoreAndPurge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, warehouseRepo, productRepo, inventoryRepo := setupTrash()
+
+	warehouse, _ := CreateTestWarehouse(db, "Warehouse", "Location")
+	product1, _ := CreateTestProduct(db, "Product 1", "TRASH-001")
+	product2, _ := CreateTestProduct(db, "Product 2", "TRASH-002")
+	inventory1, _ := CreateTestInventory(db, product1.ID, warehouse.ID, 10)
+	inventory2, _ := CreateTestInventory(db, product2.ID, warehouse.ID, 5)
+	CreateTestTransaction(db, inventory1.ID, "IN", 10)
+	CreateTestTransaction(db, inventory2.ID, "IN", 5)
+
+	// 재고 2 를 먼저 삭제한 뒤 창고 삭제 ( 재고 1 과 재고내역은 창고와 함께 삭제 )
+	if err := inventoryRepo.Delete(inventory2.ID); err != nil {
+		t.Fatalf("Failed to delete inventory: %v", err)
+	}
+	if err := warehouseRepo.Delete(warehouse.ID); err != nil {
+		t.Fatalf("Failed to delete warehouse: %v", err)
+	}
+
+	var trashResponse struct {
+		Response
+		Data struct {
+			Trash models.Trash `json:"trash"`
+		} `json:"data"`
+	}
+	rr := requestTrash(router, t, "GET", "/admin/trash", http.StatusOK)
+	if err := json.Unmarshal(rr.Body.Bytes(), &trashResponse); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	trash := trashResponse.Data.Trash
+	if len(trash.Warehouses) != 1 || len(trash.Products) != 0 || len(trash.Inventories) != 2 || len(trash.Transactions) != 2 {
+		t.Fatalf("Expected 1 warehouse, 2 inventories and 2 transactions in trash, got %+v", trash)
+	}
+	requestTrash(router, t, "GET", "/admin/trash?type=orders", http.StatusBadRequest)
+
+	// 창고가 삭제된 재고와 재고내역은 복원 불가
+	requestTrash(router, t, "POST", "/admin/trash/inventories/1/restore", http.StatusConflict)
+	requestTrash(router, t, "POST", "/admin/trash/transactions/1/restore", http.StatusConflict)
+
+	var restoreResponse struct {
+		Response
+		Data struct {
+			Restored models.TrashCount `json:"restored"`
+		} `json:"data"`
+	}
+	rr = requestTrash(router, t, "POST", "/admin/trash/warehouses/1/restore", http.StatusOK)
+	if err := json.Unmarshal(rr.Body.Bytes(), &restoreResponse); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if restored := restoreResponse.Data.Restored; restored != (models.TrashCount{Warehouses: 1, Inventories: 1, Transactions: 1}) {
+		t.Errorf("Expected warehouse with 1 inventory and 1 transaction restored, got %+v", restored)
+	}
+	requestTrash(router, t, "POST", "/admin/trash/warehouses/1/restore", http.StatusNotFound)
+
+	// 창고보다 먼저 삭제된 재고는 그대로 삭제 상태
+	if _, err := inventoryRepo.FindByID(inventory2.ID); err == nil {
+		t.Errorf("Expected inventory 2 to stay deleted")
+	}
+	restored, err := inventoryRepo.FindByID(inventory1.ID)
+	if err != nil {
+		t.Fatalf("Failed to find restored inventory: %v", err)
+	}
+	if restored.Quantity != 10 || len(restored.Transactions) != 1 {
+		t.Errorf("Expected quantity 10 with 1 transaction, got %d with %d", restored.Quantity, len(restored.Transactions))
+	}
+
+	// 재고내역 복원 요청은 함께 삭제된 재고를 복원
+	requestTrash(router, t, "POST", "/admin/trash/transactions/2/restore", http.StatusOK)
+	if _, err := inventoryRepo.FindByID(inventory2.ID); err != nil {
+		t.Errorf("Expected inventory 2 to be restored: %v", err)
+	}
+
+	// 제품 삭제 후 복원
+	if err := productRepo.Delete(product1.ID); err != nil {
+		t.Fatalf("Failed to delete product: %v", err)
+	}
+	rr = requestTrash(router, t, "POST", "/admin/trash/products/1/restore", http.StatusOK)
+	if err := json.Unmarshal(rr.Body.Bytes(), &restoreResponse); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if restored := restoreResponse.Data.Restored; restored != (models.TrashCount{Products: 1, Inventories: 1, Transactions: 1}) {
+		t.Errorf("Expected product with 1 inventory and 1 transaction restored, got %+v", restored)
+	}
+
+	// 보관 기간이 지나지 않은 레코드는 영구 삭제하지 않음
+	if err := productRepo.Delete(product2.ID); err != nil {
+		t.Fatalf("Failed to delete product: %v", err)
+	}
+	var purgeResponse struct {
+		Response
+		Data struct {
+			Purge models.TrashPurgeResult `json:"purge"`
+		} `json:"data"`
+	}
+	rr = requestTrash(router, t, "POST", "/admin/trash/purge", http.StatusOK)
+	if err := json.Unmarshal(rr.Body.Bytes(), &purgeResponse); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if purged := purgeResponse.Data.Purge.Purged; purged != (models.TrashCount{}) {
+		t.Errorf("Expected nothing purged within retention period, got %+v", purged)
+	}
+
+	// 주문이 참조하는 제품과 재고내역이 있는 재고는 영구 삭제하지 않음 ( 재고내역도 삭제하지 않음 )
+	product3, _ := CreateTestProduct(db, "Product 3", "TRASH-003")
+	product4, _ := CreateTestProduct(db, "Product 4", "TRASH-004")
+	CreateTestOrder(db, warehouse.ID, models.ORDER_STATUS_DRAFT, []models.OrderItem{{ProductID: product3.ID, Quantity: 1}})
+	for _, id := range []uint{product3.ID, product4.ID} {
+		if err := productRepo.Delete(id); err != nil {
+			t.Fatalf("Failed to delete product: %v", err)
+		}
+	}
+
+	rr = requestTrash(router, t, "POST", "/admin/trash/purge?older_than_days=0", http.StatusOK)
+	if err := json.Unmarshal(rr.Body.Bytes(), &purgeResponse); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if purged := purgeResponse.Data.Purge.Purged; purged != (models.TrashCount{Products: 1}) {
+		t.Errorf("Expected only the unreferenced product purged, got %+v", purged)
+	}
+	if skipped := purgeResponse.Data.Purge.Skipped; skipped != (models.TrashCount{Products: 2, Inventories: 1, Transactions: 1}) {
+		t.Errorf("Expected 2 products, 1 inventory and 1 transaction skipped, got %+v", skipped)
+	}
+
+	var count int64
+	db.Unscoped().Model(&models.Product{}).Where("id = ?", product4.ID).Count(&count)
+	if count != 0 {
+		t.Errorf("Expected product 4 to be permanently deleted")
+	}
+	db.Unscoped().Model(&models.Product{}).Where("id IN ?", []uint{product2.ID, product3.ID}).Count(&count)
+	if count != 2 {
+		t.Errorf("Expected referenced products to survive purge, got %d", count)
+	}
+	db.Model(&models.Order{}).Count(&count)
+	if count != 1 {
+		t.Errorf("Expected order referencing purged product to survive, got %d", count)
+	}
+	db.Unscoped().Model(&models.Transaction{}).Where("inventory_id = ?", inventory2.ID).Count(&count)
+	if count != 1 {
+		t.Errorf("Expected ledger rows to survive purge, got %d", count)
+	}
+	requestTrash(router, t, "POST", "/admin/trash/purge?older_than_days=-1", http.StatusBadRequest)
+}
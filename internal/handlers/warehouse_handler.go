@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"github.com/jhphon0730/StockFlow/internal/models"
 	"github.com/jhphon0730/StockFlow/internal/services"
 	"github.com/jhphon0730/StockFlow/pkg/dto"
 	"github.com/jhphon0730/StockFlow/pkg/utils"
@@ -16,6 +17,7 @@ type WarehouseHandler interface {
 	GetAllWarehouses(c *gin.Context)
 	GetWarehouse(c *gin.Context)
 	CreateWarehouse(c *gin.Context)
+	UpdateWarehouse(c *gin.Context)
 	DeleteWarehouse(c *gin.Context)
 }
 
@@ -32,8 +34,13 @@ func NewWarehouseHandler(warehouseService services.WarehouseService) WarehouseHa
 func (w *warehouseHandler) GetAllWarehouses(c *gin.Context) {
 	ctx := c.Request.Context()
 	search_filter := utils.GetWarehouseSearchQuery(c)
+	page, err := utils.GetPageQuery(c, models.WAREHOUSE_SORT_FIELDS, 0)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
 
-	status, warehouses, err := w.warehouseService.FindAll(ctx, search_filter)
+	status, warehouses, pagination, err := w.warehouseService.FindAll(ctx, search_filter, page)
 	if err != nil {
 		utils.JSONResponse(c, status, nil, err)
 		return
@@ -41,6 +48,7 @@ func (w *warehouseHandler) GetAllWarehouses(c *gin.Context) {
 
 	res_data := gin.H{
 		"warehouses": warehouses,
+		"pagination": pagination,
 	}
 	utils.JSONResponse(c, status, res_data, nil)
 }
@@ -122,3 +130,43 @@ func (w *warehouseHandler) DeleteWarehouse(c *gin.Context) {
 
 	utils.JSONResponse(c, status, nil, nil)
 }
+
+// 창고 수정 ( PATCH: 입력한 항목만 변경, PUT: 전체 변경 )
+func (w *warehouseHandler) UpdateWarehouse(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if id == "" {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("id is required"))
+		return
+	}
+
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var updateWarehouseDTO dto.UpdateWarehouseDTO
+	if err := c.ShouldBindJSON(&updateWarehouseDTO); err != nil {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	replace := c.Request.Method == http.MethodPut
+	if ok, err := updateWarehouseDTO.CheckUpdateWarehouseDTO(replace); !ok {
+		utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	status, warehouse, err := w.warehouseService.Update(uint(id_int), updateWarehouseDTO.ToUpdates(replace), ctx)
+	if err != nil {
+		utils.JSONResponse(c, status, nil, err)
+		return
+	}
+
+	res_data := gin.H{
+		"warehouse": warehouse,
+	}
+
+	utils.JSONResponse(c, status, res_data, nil)
+}
@@ -192,3 +192,46 @@ func TestDeleteWarehouse(t *testing.T) {
 		t.Errorf("Expected warehouse to be deleted")
 	}
 }
+
+func TestUpdateWarehouse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, router, warehouseRepo, _, warehouseHandler := setupWarehouse()
+	router.PUT("/warehouses/:id", warehouseHandler.UpdateWarehouse)
+	router.PATCH("/warehouses/:id", warehouseHandler.UpdateWarehouse)
+
+	warehouse, err := CreateTestWarehouse(db, "Warehouse", "Seoul")
+	if err != nil {
+		t.Fatalf("Failed to create test warehouse: %v", err)
+	}
+	path := "/warehouses/" + strconv.Itoa(int(warehouse.ID))
+
+	for _, tc := range []struct {
+		method   string
+		path     string
+		payload  map[string]interface{}
+		expected int
+		location string
+		policy   string
+	}{
+		{"PATCH", path, map[string]interface{}{"negative_stock_policy": models.NEGATIVE_STOCK_WARN}, http.StatusOK, "Seoul", models.NEGATIVE_STOCK_WARN},
+		{"PATCH", path, map[string]interface{}{"location": "Busan"}, http.StatusOK, "Busan", models.NEGATIVE_STOCK_WARN},
+		{"PATCH", path, map[string]interface{}{"negative_stock_policy": "NEVER"}, http.StatusBadRequest, "Busan", models.NEGATIVE_STOCK_WARN},
+		{"PUT", path, map[string]interface{}{"name": "Warehouse"}, http.StatusBadRequest, "Busan", models.NEGATIVE_STOCK_WARN}, // 위치 누락
+		// PUT 은 입력하지 않은 정책을 기본값으로 변경
+		{"PUT", path, map[string]interface{}{"name": "Warehouse", "location": "Incheon"}, http.StatusOK, "Incheon", models.NEGATIVE_STOCK_FORBID},
+		{"PATCH", "/warehouses/999", map[string]interface{}{"location": "Busan"}, http.StatusNotFound, "Incheon", models.NEGATIVE_STOCK_FORBID},
+	} {
+		rr := sendJSON(router, t, tc.method, tc.path, tc.payload)
+		if rr.Code != tc.expected {
+			t.Fatalf("Expected status code %d for %s %v, got %d: %s", tc.expected, tc.method, tc.payload, rr.Code, rr.Body.String())
+		}
+
+		updated, err := warehouseRepo.FindByID(warehouse.ID)
+		if err != nil {
+			t.Fatalf("Failed to find warehouse: %v", err)
+		}
+		if updated.Location != tc.location || updated.NegativeStockPolicy != tc.policy {
+			t.Errorf("Expected location %s and policy %s, got %s and %s", tc.location, tc.policy, updated.Location, updated.NegativeStockPolicy)
+		}
+	}
+}
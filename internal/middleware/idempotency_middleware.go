@@ -0,0 +1,168 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+)
+
+const (
+	IDEMPOTENCY_KEY_HEADER      = "Idempotency-Key"
+	IDEMPOTENCY_REPLAYED_HEADER = "Idempotent-Replayed"
+	idempotencyKeyTTL           = 24 * time.Hour   // 응답을 저장한 키의 보관 기간
+	idempotencyKeyPendingTTL    = time.Minute      // 처리 중인 키의 보관 기간 ( 처리 중에는 계속 연장, 응답 저장 전에 서버가 중단되어도 이후 다시 시도 가능 )
+	idempotencyKeyRefreshPeriod = 20 * time.Second // 처리 중인 키의 보관 기간 연장 주기
+	idempotencyKeyMaxLength     = 255
+)
+
+// 응답 본문을 함께 기록하는 ResponseWriter
+type bodyCaptureWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bodyCaptureWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *bodyCaptureWriter) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
+
+// POST 요청에 Idempotency-Key 헤더가 있으면 첫 응답을 저장하고, 같은 키의 재요청에는 저장된 응답 반환
+// - 같은 키를 다른 요청 본문(또는 경로)에 사용하면 422
+// - 첫 요청이 아직 처리 중이면 409
+// - 5xx 응답, 처리 중 panic, 응답 저장 실패 시 키를 삭제 ( 같은 키로 다시 시도 가능 )
+// ( AuthMiddleware 다음에 등록해 사용자별로 키 구분 )
+func IdempotencyMiddleware(idempotencyKeyRepository repositories.IdempotencyKeyRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IDEMPOTENCY_KEY_HEADER)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+			return
+		}
+
+		if len(key) > idempotencyKeyMaxLength {
+			utils.JSONResponse(c, http.StatusBadRequest, nil, errors.New("Idempotency-Key 는 255자 이하여야 합니다"))
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			utils.JSONResponse(c, http.StatusBadRequest, nil, err)
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		hash := sha256.New()
+		hash.Write([]byte(c.Request.Method + " " + c.Request.URL.Path + "\n"))
+		hash.Write(body)
+		requestHash := hex.EncodeToString(hash.Sum(nil))
+
+		userID := c.GetUint("userID")
+		now := time.Now()
+
+		existing, err := idempotencyKeyRepository.FindByKey(userID, key)
+		if err == nil && existing.ExpiresAt.Before(now) {
+			deleted, err := idempotencyKeyRepository.DeleteExpired(existing.ID, existing.StatusCode, now)
+			if err != nil {
+				utils.JSONResponse(c, http.StatusInternalServerError, nil, err)
+				c.Abort()
+				return
+			}
+
+			existing = nil
+			if !deleted {
+				// 그 사이 처리 중인 요청이 보관 기간을 연장했거나 응답을 저장한 경우 다시 조회
+				if found, err := idempotencyKeyRepository.FindByKey(userID, key); err == nil {
+					existing = found
+				}
+			}
+		}
+
+		var record *models.IdempotencyKey
+		if existing == nil {
+			record, err = idempotencyKeyRepository.Create(&models.IdempotencyKey{
+				Key:         key,
+				UserID:      userID,
+				Method:      c.Request.Method,
+				Path:        c.Request.URL.Path,
+				RequestHash: requestHash,
+				ExpiresAt:   now.Add(idempotencyKeyPendingTTL),
+			})
+			if err != nil {
+				// 같은 키의 요청이 동시에 등록된 경우
+				existing, err = idempotencyKeyRepository.FindByKey(userID, key)
+				if err != nil {
+					utils.JSONResponse(c, http.StatusInternalServerError, nil, err)
+					c.Abort()
+					return
+				}
+			}
+		}
+
+		if existing != nil {
+			switch {
+			case existing.RequestHash != requestHash:
+				utils.JSONResponse(c, http.StatusUnprocessableEntity, nil, errors.New("다른 요청에 이미 사용된 Idempotency-Key 입니다"))
+			case existing.StatusCode == 0:
+				utils.JSONResponse(c, http.StatusConflict, nil, errors.New("같은 Idempotency-Key 의 요청을 처리 중입니다"))
+			default:
+				c.Header(IDEMPOTENCY_REPLAYED_HEADER, "true")
+				c.Data(existing.StatusCode, existing.ContentType, existing.ResponseBody)
+			}
+			c.Abort()
+			return
+		}
+
+		// 응답을 저장하지 못한 채 끝나면 ( panic 포함 ) 처리 중 상태로 남지 않도록 키 삭제
+		saved := false
+		defer func() {
+			if !saved {
+				_ = idempotencyKeyRepository.Delete(record.ID)
+			}
+		}()
+
+		// 처리 시간이 보관 기간보다 길어도 같은 키의 재요청이 다시 실행되지 않도록 처리 중에는 보관 기간 연장
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			ticker := time.NewTicker(idempotencyKeyRefreshPeriod)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-done:
+					return
+				case <-ticker.C:
+					_, _ = idempotencyKeyRepository.Refresh(record.ID, time.Now().Add(idempotencyKeyPendingTTL))
+				}
+			}
+		}()
+
+		writer := &bodyCaptureWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+
+		if writer.Status() >= http.StatusInternalServerError {
+			return
+		}
+
+		updated, err := idempotencyKeyRepository.SaveResponse(record.ID, writer.Status(), writer.Header().Get("Content-Type"), writer.body.Bytes(), time.Now().Add(idempotencyKeyTTL))
+		saved = err == nil && updated
+	}
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+const (
+	BARCODE_EAN13   = "EAN13"   // 13자리 EAN
+	BARCODE_EAN8    = "EAN8"    // 8자리 EAN
+	BARCODE_UPCA    = "UPCA"    // 12자리 UPC-A
+	BARCODE_CODE128 = "CODE128" // 출력 가능한 ASCII 문자열
+)
+
+/* 제품(및 단위)별 바코드 저장 ( 바코드 값은 전체 제품에서 유일 ) */
+type Barcode struct {
+	gorm.Model
+	ProductID uint   `json:"product_id" gorm:"index" binding:"required" validate:"required"`
+	Code      string `json:"code" gorm:"unique" binding:"required" validate:"required"`
+	Symbology string `json:"symbology" gorm:"default:CODE128" validate:"oneof=EAN13 EAN8 UPCA CODE128"`
+	Unit      string `json:"unit"` // 바코드가 가리키는 단위 ( 빈 값은 기본 단위, 예: 박스 바코드는 CASE )
+
+	// 연관관계
+	Product *Product `json:"product,omitempty" gorm:"foreignKey:ProductID;constraint:OnDelete:CASCADE"` // Product 삭제 시 Barcode 삭제
+}
+
+/* 바코드 스캔 결과 ( 저장하지 않음 ) */
+type ScanResult struct {
+	Code          string      `json:"code"`
+	Barcode       *Barcode    `json:"barcode,omitempty"` // SKU 로 조회된 경우 nil
+	Product       *Product    `json:"product"`
+	Unit          string      `json:"unit"`           // 스캔한 코드의 단위
+	UnitFactor    int         `json:"unit_factor"`    // 스캔한 단위 1개의 기본 단위 수량
+	Inventories   []Inventory `json:"inventories"`    // 창고별 재고
+	TotalQuantity int         `json:"total_quantity"` // 전체 창고 보유 수량 ( 기본 단위 )
+}
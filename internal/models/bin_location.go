@@ -0,0 +1,52 @@
+package models
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+/* 창고 내부 보관 위치 ( 구역 > 통로 > 선반 > 칸 ) */
+type BinLocation struct {
+	gorm.Model
+	WarehouseID uint   `json:"warehouse_id" gorm:"uniqueIndex:idx_bin_location_warehouse_code" binding:"required" validate:"required"`
+	Zone        string `json:"zone" binding:"required" validate:"required"`             // 구역
+	Aisle       string `json:"aisle"`                                                   // 통로
+	Shelf       string `json:"shelf"`                                                   // 선반
+	Bin         string `json:"bin"`                                                     // 칸
+	Code        string `json:"code" gorm:"uniqueIndex:idx_bin_location_warehouse_code"` // 구역-통로-선반-칸 ( 창고 내 유일 )
+
+	// 연관관계
+	Warehouse *Warehouse `gorm:"foreignKey:WarehouseID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`              // Warehouse 삭제 시 BinLocation 삭제
+	BinStocks []BinStock `json:"bin_stocks,omitempty" gorm:"foreignKey:BinLocationID;constraint:OnDelete:CASCADE"` // 위치에 보관된 재고
+}
+
+// 생성 전 위치 코드 생성
+func (b *BinLocation) BeforeCreate(tx *gorm.DB) error {
+	b.Code = b.BuildCode()
+	return nil
+}
+
+// 비어 있지 않은 단계만 이어 붙여 위치 코드 생성 ( 예: A-01-03-2 )
+func (b *BinLocation) BuildCode() string {
+	code := b.Zone
+	for _, part := range []string{b.Aisle, b.Shelf, b.Bin} {
+		if part != "" {
+			code = fmt.Sprintf("%s-%s", code, part)
+		}
+	}
+
+	return code
+}
+
+/* 보관 위치별 재고 수량 저장 ( 재고 수량 중 위치가 지정된 수량 ) */
+type BinStock struct {
+	gorm.Model
+	InventoryID   uint `json:"inventory_id" gorm:"uniqueIndex:idx_bin_stock_inventory_bin" binding:"required" validate:"required"`
+	BinLocationID uint `json:"bin_location_id" gorm:"uniqueIndex:idx_bin_stock_inventory_bin" binding:"required" validate:"required"`
+	Quantity      int  `json:"quantity" validate:"gte=0"`
+
+	// 연관관계
+	Inventory   *Inventory   `gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"`   // Inventory 삭제 시 BinStock 삭제
+	BinLocation *BinLocation `gorm:"foreignKey:BinLocationID;constraint:OnDelete:CASCADE"` // BinLocation 삭제 시 BinStock 삭제
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+/* 제품 분류 트리 저장 ( 최상위 분류는 ParentID 가 없음 ) */
+type Category struct {
+	gorm.Model
+	Name     string `json:"name" gorm:"uniqueIndex:idx_category_parent_name" binding:"required" validate:"required"`
+	ParentID *uint  `json:"parent_id" gorm:"uniqueIndex:idx_category_parent_name"`
+
+	// 연관관계
+	Children []Category `json:"children,omitempty" gorm:"foreignKey:ParentID"` // 조회 시 Service 에서 트리로 구성
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	COST_METHOD_FIFO    = "FIFO"    // 먼저 입고된 원가층부터 출고 원가로 사용
+	COST_METHOD_AVERAGE = "AVERAGE" // 입고 시마다 갱신되는 이동평균 원가를 출고 원가로 사용
+)
+
+/* 재고별 입고 원가층 저장 ( 입고 단가와 남은 수량 ) */
+type CostLayer struct {
+	gorm.Model
+	InventoryID       uint      `json:"inventory_id" gorm:"index" binding:"required" validate:"required"`
+	TransactionID     uint      `json:"transaction_id"` // 원가층을 생성한 입고(조정) 재고내역
+	UnitCost          float64   `json:"unit_cost" validate:"gte=0"`
+	Quantity          int       `json:"quantity" validate:"gt=0"`            // 입고 수량
+	RemainingQuantity int       `json:"remaining_quantity" validate:"gte=0"` // 아직 출고되지 않은 수량
+	ReceivedAt        time.Time `json:"received_at" gorm:"index"`            // FIFO 소진 순서 기준
+
+	// 연관관계
+	Inventory *Inventory `gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"` // Inventory 삭제 시 CostLayer 삭제
+}
+
+/* 재고별 평가 금액 ( 저장하지 않음 ) */
+type InventoryValuation struct {
+	InventoryID   uint    `json:"inventory_id"`
+	WarehouseID   uint    `json:"warehouse_id"`
+	WarehouseName string  `json:"warehouse_name"`
+	ProductID     uint    `json:"product_id"`
+	ProductName   string  `json:"product_name"`
+	SKU           string  `json:"sku"`
+	CostMethod    string  `json:"cost_method"`
+	Quantity      int     `json:"quantity"`
+	UnitCost      float64 `json:"unit_cost"`
+	TotalValue    float64 `json:"total_value"`
+}
+
+/* 창고 또는 제품 단위 평가 금액 합계 ( 저장하지 않음 ) */
+type ValuationSubtotal struct {
+	ID         uint    `json:"id"`
+	Name       string  `json:"name"`
+	Quantity   int     `json:"quantity"`
+	TotalValue float64 `json:"total_value"`
+}
+
+/* 재고 평가 보고서 ( 저장하지 않음 ) */
+type ValuationReport struct {
+	Items      []InventoryValuation `json:"items"`
+	Warehouses []ValuationSubtotal  `json:"warehouses"`
+	Products   []ValuationSubtotal  `json:"products"`
+	TotalValue float64              `json:"total_value"`
+}
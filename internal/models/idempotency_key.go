@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+/* Idempotency-Key 별 요청 해시와 응답 저장 ( 같은 키로 다시 요청하면 저장된 응답 반환 ) */
+type IdempotencyKey struct {
+	gorm.Model
+	Key          string    `json:"key" gorm:"uniqueIndex:idx_idempotency_key_user;size:255"`
+	UserID       uint      `json:"user_id" gorm:"uniqueIndex:idx_idempotency_key_user"` // 키를 사용한 사용자 ( 사용자별로 구분 )
+	Method       string    `json:"method"`
+	Path         string    `json:"path"`
+	RequestHash  string    `json:"request_hash"`                 // 메서드 + 경로 + 본문의 SHA-256
+	StatusCode   int       `json:"status_code" gorm:"default:0"` // 0: 처리 중
+	ContentType  string    `json:"content_type"`
+	ResponseBody []byte    `json:"-"`
+	ExpiresAt    time.Time `json:"expires_at" gorm:"index"` // 이후에는 같은 키를 새 요청으로 처리
+}
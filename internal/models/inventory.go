@@ -9,11 +9,80 @@ type Inventory struct {
 	gorm.Model
 	WarehouseID uint `json:"warehouse_id" binding:"required" validate:"required"`
 	ProductID   uint `json:"product_id" binding:"required" validate:"required"`
-	Quantity    int  `json:"quantity" binding:"required" validate:"required,gte=0"`
+	Quantity    int  `json:"quantity" binding:"required" validate:"required,gte=0"` // 보유 수량 ( on-hand )
+
+	ReservedQuantity  int `json:"reserved_quantity" gorm:"default:0" validate:"gte=0"` // 예약된 수량
+	AvailableQuantity int `json:"available_quantity" gorm:"-"`                         // 가용 수량 = 보유 수량 - 예약된 수량
+
+	// 재고 임계치 ( 0 은 미설정 )
+	MinQuantity  int `json:"min_quantity" gorm:"default:0" validate:"gte=0"`  // 최소 수량
+	ReorderPoint int `json:"reorder_point" gorm:"default:0" validate:"gte=0"` // 재주문점
+	MaxQuantity  int `json:"max_quantity" gorm:"default:0" validate:"gte=0"`  // 최대 수량
+
+	AverageCost float64 `json:"average_cost" gorm:"default:0"` // 보유 수량의 단위 원가 ( FIFO: 남은 원가층 평균, AVERAGE: 이동평균 )
+
+	Version int `json:"version" gorm:"default:0"` // 수량 변경 시마다 증가 ( 조회 후 다른 요청이 먼저 변경했는지 확인 )
 
 	// 연관관계
-	Warehouse    *Warehouse    `gorm:"foreignKey:WarehouseID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"` // Warehouse 삭제 시 Inventory 삭제
-	Product      *Product      `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`   // Product 삭제 시 Inventory 삭제
-	Transactions []Transaction `gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"`                  // Inventory 삭제 시 Transaction 삭제
+	Warehouse    *Warehouse    `gorm:"foreignKey:WarehouseID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`             // Warehouse 삭제 시 Inventory 삭제
+	Product      *Product      `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`               // Product 삭제 시 Inventory 삭제
+	Transactions []Transaction `gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"`                              // Inventory 삭제 시 Transaction 삭제
+	Lots         []Lot         `json:"lots,omitempty" gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"`        // Inventory 삭제 시 Lot 삭제
+	BinStocks    []BinStock    `json:"bin_stocks,omitempty" gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"`  // Inventory 삭제 시 BinStock 삭제
+	CostLayers   []CostLayer   `json:"cost_layers,omitempty" gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"` // Inventory 삭제 시 CostLayer 삭제
+}
+
+// 조회 시 가용 수량 계산
+func (i *Inventory) AfterFind(tx *gorm.DB) error {
+	i.AvailableQuantity = i.Quantity - i.ReservedQuantity
+	return nil
+}
+
+// 재고내역 유형에 따라 반영될 수량 계산
+func (i *Inventory) NextQuantity(transactionType string, quantity int) int {
+	switch transactionType {
+	case "IN":
+		return i.Quantity + quantity
+	case "OUT":
+		return i.Quantity - quantity
+	case "ADJUST":
+		return quantity
+	}
+
+	return i.Quantity
+}
+
+// 변경 전 수량에서 현재 수량으로 바뀌며 넘어선 임계치의 알림 단계와 기준 수량 반환 ( 넘어선 임계치가 없으면 "" )
+func (i *Inventory) CrossedThreshold(previous int) (string, int) {
+	if i.MinQuantity > 0 && previous >= i.MinQuantity && i.Quantity < i.MinQuantity {
+		return STOCK_ALERT_BELOW_MIN, i.MinQuantity
+	}
+
+	if i.ReorderPoint > 0 && previous > i.ReorderPoint && i.Quantity <= i.ReorderPoint {
+		return STOCK_ALERT_REORDER, i.ReorderPoint
+	}
+
+	if i.MaxQuantity > 0 && previous <= i.MaxQuantity && i.Quantity > i.MaxQuantity {
+		return STOCK_ALERT_ABOVE_MAX, i.MaxQuantity
+	}
+
+	return "", 0
 }
 
+// 목록 정렬 컬럼의 값 ( 다음 페이지 cursor 생성에 사용 )
+func (i *Inventory) SortValue(field string) interface{} {
+	switch field {
+	case "warehouse_id":
+		return i.WarehouseID
+	case "product_id":
+		return i.ProductID
+	case "quantity":
+		return i.Quantity
+	case "created_at":
+		return i.CreatedAt
+	case "updated_at":
+		return i.UpdatedAt
+	}
+
+	return i.ID
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+)
+
+/* 재고 수량과 재고내역 재계산 수량이 다른 재고 ( 저장하지 않음 ) */
+type LedgerMismatch struct {
+	InventoryID    uint   `json:"inventory_id"`
+	WarehouseID    uint   `json:"warehouse_id"`
+	WarehouseName  string `json:"warehouse_name"`
+	ProductID      uint   `json:"product_id"`
+	ProductName    string `json:"product_name"`
+	SKU            string `json:"sku"`
+	Quantity       int    `json:"quantity"`        // 현재 재고 수량
+	LedgerQuantity int    `json:"ledger_quantity"` // 재고내역을 처음부터 다시 반영한 수량
+	Difference     int    `json:"difference"`      // 재고 수량 - 재고내역 수량
+
+	// 보정 결과 ( 보정을 요청한 경우 )
+	RepairTransactionID *uint  `json:"repair_transaction_id,omitempty"`
+	RepairError         string `json:"repair_error,omitempty"`
+}
+
+/* 재고내역 정합성 검사 결과 ( 저장하지 않음 ) */
+type LedgerCheckReport struct {
+	CheckedAt      time.Time        `json:"checked_at"`
+	InventoryCount int              `json:"inventory_count"` // 검사한 재고 수
+	Mismatches     []LedgerMismatch `json:"mismatches"`
+	Repaired       bool             `json:"repaired"`  // 보정 요청 여부
+	Reference      string           `json:"reference"` // 보정 재고내역의 참조 값
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 로트 번호 없이 시스템이 생성한 조정(ADJUST)의 증가 수량을 입고하는 로트 ( 실사 승인, 재고내역 불일치 보정 )
+const DEFAULT_LOT_NUMBER = "UNASSIGNED"
+
+/* 재고별 로트(배치) 정보 저장 ( 로트 관리 제품만 사용 ) */
+type Lot struct {
+	gorm.Model
+	InventoryID    uint       `json:"inventory_id" gorm:"uniqueIndex:idx_lot_inventory_number" binding:"required" validate:"required"`
+	LotNumber      string     `json:"lot_number" gorm:"uniqueIndex:idx_lot_inventory_number" binding:"required" validate:"required"`
+	ManufacturedAt *time.Time `json:"manufactured_at"`         // 제조일
+	ExpiresAt      *time.Time `json:"expires_at" gorm:"index"` // 유통기한 ( FEFO 출고 기준 )
+	Quantity       int        `json:"quantity" validate:"gte=0"`
+
+	// 연관관계
+	Inventory *Inventory `gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"` // Inventory 삭제 시 Lot 삭제
+}
+
+/* 재고내역별 입고/소진된 로트 수량 저장 */
+type TransactionLot struct {
+	gorm.Model
+	TransactionID uint `json:"transaction_id" binding:"required" validate:"required"`
+	LotID         uint `json:"lot_id" binding:"required" validate:"required"`
+	Quantity      int  `json:"quantity" binding:"required" validate:"required,gt=0"`
+
+	// 연관관계
+	Lot *Lot `gorm:"foreignKey:LotID;constraint:OnDelete:CASCADE"` // Lot 삭제 시 TransactionLot 삭제
+}
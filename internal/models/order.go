@@ -0,0 +1,66 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ORDER_STATUS_DRAFT     = "DRAFT"     // 작성 중
+	ORDER_STATUS_APPROVED  = "APPROVED"  // 승인
+	ORDER_STATUS_PICKING   = "PICKING"   // 피킹 중
+	ORDER_STATUS_SHIPPED   = "SHIPPED"   // 출고 완료
+	ORDER_STATUS_CANCELLED = "CANCELLED" // 취소
+)
+
+// 주문 상태 전환 규칙 ( 현재 상태 -> 변경 가능한 상태 )
+var orderStatusTransitions = map[string][]string{
+	ORDER_STATUS_DRAFT:    {ORDER_STATUS_APPROVED, ORDER_STATUS_CANCELLED},
+	ORDER_STATUS_APPROVED: {ORDER_STATUS_PICKING, ORDER_STATUS_CANCELLED},
+	ORDER_STATUS_PICKING:  {ORDER_STATUS_SHIPPED, ORDER_STATUS_CANCELLED},
+}
+
+/* 주문 정보 저장 */
+type Order struct {
+	gorm.Model
+	UserID      uint       `json:"user_id"`
+	WarehouseID uint       `json:"warehouse_id" binding:"required" validate:"required"`
+	Customer    string     `json:"customer" binding:"required" validate:"required"`
+	Status      string     `json:"status" gorm:"default:DRAFT" validate:"oneof=DRAFT APPROVED PICKING SHIPPED CANCELLED"`
+	Note        string     `json:"note"` // 선택적 메모
+	ShippedAt   *time.Time `json:"shipped_at"`
+
+	// 연관관계
+	User       *User       `gorm:"foreignKey:UserID"`
+	Warehouse  *Warehouse  `gorm:"foreignKey:WarehouseID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"` // Warehouse 삭제 시 Order 삭제
+	OrderItems []OrderItem `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`                      // Order 삭제 시 OrderItem 삭제
+}
+
+/* 주문 항목 정보 저장 */
+type OrderItem struct {
+	gorm.Model
+	OrderID   uint `json:"order_id" binding:"required" validate:"required"`
+	ProductID uint `json:"product_id" binding:"required" validate:"required"`
+	Quantity  int  `json:"quantity" binding:"required" validate:"required,gt=0"`
+
+	// 연관관계
+	Order   *Order   `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`                    // Order 삭제 시 OrderItem 삭제
+	Product *Product `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"` // Product 삭제 시 OrderItem 삭제
+}
+
+// 현재 상태에서 next 상태로 전환이 가능한지 확인
+func (o *Order) CanTransitionTo(next string) bool {
+	for _, status := range orderStatusTransitions[o.Status] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+// 주문 출고 재고내역과 주문 예약에 사용하는 참조 ( 예: ORDER-1 )
+func (o *Order) Reference() string {
+	return fmt.Sprintf("ORDER-%d", o.ID)
+}
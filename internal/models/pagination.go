@@ -0,0 +1,117 @@
+package models
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	DEFAULT_PAGE_SIZE = 50  // page 또는 cursor 만 지정하고 size 미지정 시, 재고내역 목록은 조건 생략 시에도
+	MAX_PAGE_SIZE     = 500 // 한 번에 조회할 수 있는 최대 건수
+
+	SORT_ORDER_ASC  = "asc"
+	SORT_ORDER_DESC = "desc"
+)
+
+// 목록별 정렬 가능 컬럼 ( 첫 번째 값이 기본 정렬 )
+var (
+	WAREHOUSE_SORT_FIELDS   = []string{"id", "name", "location", "created_at", "updated_at"}
+	PRODUCT_SORT_FIELDS     = []string{"id", "name", "sku", "created_at", "updated_at"}
+	INVENTORY_SORT_FIELDS   = []string{"id", "warehouse_id", "product_id", "quantity", "created_at", "updated_at"}
+	TRANSACTION_SORT_FIELDS = []string{"id", "timestamp", "type", "quantity", "created_at"}
+)
+
+// 시간 값으로 정렬하는 컬럼 ( cursor 의 정렬 값을 시간으로 해석 )
+var TIME_SORT_FIELDS = []string{"timestamp", "created_at", "updated_at"}
+
+/* 목록 조회 조건 ( 저장하지 않음 ) */
+type PageQuery struct {
+	Page   int     // 1부터 시작 ( Cursor 를 지정하면 무시 )
+	Size   int     // 페이지 크기 ( 0 은 페이지 조건 없이 전체 조회 )
+	Cursor *Cursor // next_cursor 를 해석한 값 ( nil 은 미지정 )
+	Sort   string  // 정렬 컬럼 ( 목록별 허용 컬럼만 )
+	Order  string  // asc, desc
+}
+
+// 페이지 조건을 목록 캐시 키로 변환 ( 같은 조건이면 같은 키 )
+func (p PageQuery) CacheKey() string {
+	key := fmt.Sprintf("%s:%s:%d:%d", p.Sort, p.Order, p.Size, p.Page)
+	if p.Cursor != nil {
+		if cursor, err := EncodeCursor(*p.Cursor); err == nil {
+			key += ":" + cursor
+		}
+	}
+
+	return key
+}
+
+/* 이전 페이지 마지막 레코드 위치 ( next_cursor 로 인코딩, 저장하지 않음 ) */
+type Cursor struct {
+	Sort  string      `json:"sort"`
+	Order string      `json:"order"`
+	Value interface{} `json:"value"` // 마지막 레코드의 정렬 값
+	ID    uint        `json:"id"`    // 마지막 레코드 ID ( 정렬 값이 같은 레코드 구분 )
+}
+
+/* 목록 응답의 페이지 정보 ( 저장하지 않음 ) */
+type Pagination struct {
+	Total      int64  `json:"total"`          // 검색 조건에 맞는 전체 건수
+	Page       int    `json:"page,omitempty"` // cursor 로 조회한 경우 0
+	Size       int    `json:"size"`           // 페이지 조건 없이 전체 조회한 경우 0
+	Sort       string `json:"sort"`
+	Order      string `json:"order"`
+	NextCursor string `json:"next_cursor,omitempty"` // 다음 페이지 조회 시 cursor 로 전달 ( 마지막 페이지면 빈 값 )
+}
+
+// cursor 를 다음 페이지 조회 값으로 변환
+func EncodeCursor(cursor Cursor) (string, error) {
+	encoded, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+// 다음 페이지 조회 값을 cursor 로 변환 ( 정렬 값은 정렬 컬럼에 맞는 타입으로 변환 )
+func DecodeCursor(value string) (*Cursor, error) {
+	invalid := errors.New("cursor 가 올바르지 않습니다")
+
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, invalid
+	}
+
+	var cursor Cursor
+	decoder := json.NewDecoder(bytes.NewReader(decoded))
+	decoder.UseNumber()
+	if err := decoder.Decode(&cursor); err != nil || cursor.ID == 0 || cursor.Sort == "" {
+		return nil, invalid
+	}
+
+	switch sortValue := cursor.Value.(type) {
+	case json.Number:
+		number, err := sortValue.Int64()
+		if err != nil {
+			return nil, invalid
+		}
+		cursor.Value = number
+	case string:
+		if slices.Contains(TIME_SORT_FIELDS, cursor.Sort) {
+			timeValue, err := time.Parse(time.RFC3339Nano, sortValue)
+			if err != nil {
+				return nil, invalid
+			}
+			cursor.Value = timeValue
+		}
+	default:
+		return nil, invalid
+	}
+
+	return &cursor, nil
+}
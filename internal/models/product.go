@@ -1,15 +1,129 @@
 package models
 
 import (
+	"fmt"
+	"strconv"
+
 	"gorm.io/gorm"
 )
 
+// 자주 사용하는 단위 ( 제품별로 다른 이름도 등록 가능 )
+const (
+	UNIT_EACH       = "EA"     // 낱개
+	UNIT_INNER_PACK = "INNER"  // 내포장
+	UNIT_CASE       = "CASE"   // 박스
+	UNIT_PALLET     = "PALLET" // 팔레트
+)
+
+const (
+	ATTRIBUTE_TYPE_STRING  = "STRING"  // 문자열
+	ATTRIBUTE_TYPE_NUMBER  = "NUMBER"  // 숫자
+	ATTRIBUTE_TYPE_BOOLEAN = "BOOLEAN" // true / false
+)
+
 /* 제품 정보 저장 */
 type Product struct {
 	gorm.Model
-	Name        string      `json:"name" binding:"required" validate:"required"`
-	Description string      `json:"description"` // 선택적 설명
-	SKU         string      `json:"sku" gorm:"unique" binding:"required" validate:"required"`
-	Inventories []Inventory `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Name        string        `json:"name" binding:"required" validate:"required"`
+	Description string        `json:"description"` // 선택적 설명
+	SKU         string        `json:"sku" gorm:"unique" binding:"required" validate:"required"`
+	LotTracked  bool          `json:"lot_tracked" gorm:"default:false"`                              // 로트/유통기한 관리 여부
+	Serialized  bool          `json:"serialized" gorm:"default:false"`                               // 일련번호 관리 여부
+	CostMethod  string        `json:"cost_method" gorm:"default:FIFO" validate:"oneof=FIFO AVERAGE"` // 출고 원가 계산 방식
+	BaseUnit    string        `json:"base_unit" gorm:"default:EA"`                                   // 기본 단위 ( 재고/재고내역 수량은 항상 기본 단위로 저장 )
+	Units       []ProductUnit `json:"units" gorm:"foreignKey:ProductID;constraint:OnDelete:CASCADE"` // 기본 단위 외 환산 단위
+	CategoryID  *uint         `json:"category_id" gorm:"index"`                                      // 분류 ( 선택 )
+	ParentID    *uint         `json:"parent_id" gorm:"index"`                                        // 상위 제품 ( 변형 제품인 경우 )
+
+	// 보유 수량 합계 ( 저장하지 않음, 조회 시 계산 )
+	StockQuantity  int `json:"stock_quantity" gorm:"-"`  // 이 제품 재고의 합계
+	RollupQuantity int `json:"rollup_quantity" gorm:"-"` // 이 제품 + 변형 제품 재고의 합계
+
+	// 연관관계
+	Category    *Category          `json:"category,omitempty" gorm:"foreignKey:CategoryID;constraint:OnDelete:SET NULL"`
+	Attributes  []ProductAttribute `json:"attributes" gorm:"foreignKey:ProductID;constraint:OnDelete:CASCADE"` // 사용자 정의 속성
+	Variants    []Product          `json:"variants,omitempty" gorm:"foreignKey:ParentID"`                      // 변형 제품 ( 사이즈, 색상 등 )
+	Inventories []Inventory        `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+/* 제품별 사용자 정의 속성 저장 ( 값은 Type 에 맞는 문자열로 저장 ) */
+type ProductAttribute struct {
+	gorm.Model
+	ProductID uint   `json:"product_id" gorm:"uniqueIndex:idx_product_attribute_name" binding:"required" validate:"required"`
+	Name      string `json:"name" gorm:"uniqueIndex:idx_product_attribute_name" binding:"required" validate:"required"`
+	Type      string `json:"type" gorm:"default:STRING" validate:"oneof=STRING NUMBER BOOLEAN"`
+	Value     string `json:"value"`
+}
+
+/* 제품별 환산 단위 저장 ( 1 단위 = Factor x 기본 단위 ) */
+type ProductUnit struct {
+	gorm.Model
+	ProductID uint   `json:"product_id" gorm:"uniqueIndex:idx_product_unit_name" binding:"required" validate:"required"`
+	Name      string `json:"name" gorm:"uniqueIndex:idx_product_unit_name" binding:"required" validate:"required"`
+	Factor    int    `json:"factor" binding:"required" validate:"required,gt=0"`
 }
 
+// 지정 단위 1개에 해당하는 기본 단위 수량 ( 빈 값은 기본 단위 )
+func (p *Product) UnitFactor(unit string) (int, error) {
+	if unit == "" || unit == p.BaseUnit {
+		return 1, nil
+	}
+
+	for _, productUnit := range p.Units {
+		if productUnit.Name == unit {
+			return productUnit.Factor, nil
+		}
+	}
+
+	return 0, fmt.Errorf("제품에 등록되지 않은 단위입니다 (%s)", unit)
+}
+
+// 속성 값이 Type 에 맞는지 확인
+func (a *ProductAttribute) CheckValue() error {
+	switch a.Type {
+	case "", ATTRIBUTE_TYPE_STRING:
+		return nil
+	case ATTRIBUTE_TYPE_NUMBER:
+		if _, err := strconv.ParseFloat(a.Value, 64); err != nil {
+			return fmt.Errorf("숫자 속성의 값이 올바르지 않습니다 (%s: %s)", a.Name, a.Value)
+		}
+		return nil
+	case ATTRIBUTE_TYPE_BOOLEAN:
+		if _, err := strconv.ParseBool(a.Value); err != nil {
+			return fmt.Errorf("true/false 속성의 값이 올바르지 않습니다 (%s: %s)", a.Name, a.Value)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("지원하지 않는 속성 유형입니다 (%s)", a.Type)
+}
+
+// 제품 재고와 변형 제품 재고를 합산 ( Inventories, Variants.Inventories 를 조회한 경우 )
+func (p *Product) RollUpStock() {
+	p.StockQuantity = 0
+	for _, inventory := range p.Inventories {
+		p.StockQuantity += inventory.Quantity
+	}
+
+	p.RollupQuantity = p.StockQuantity
+	for i := range p.Variants {
+		p.Variants[i].RollUpStock()
+		p.RollupQuantity += p.Variants[i].RollupQuantity
+	}
+}
+
+// 목록 정렬 컬럼의 값 ( 다음 페이지 cursor 생성에 사용 )
+func (p *Product) SortValue(field string) interface{} {
+	switch field {
+	case "name":
+		return p.Name
+	case "sku":
+		return p.SKU
+	case "created_at":
+		return p.CreatedAt
+	case "updated_at":
+		return p.UpdatedAt
+	}
+
+	return p.ID
+}
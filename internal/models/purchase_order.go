@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	PURCHASE_STATUS_DRAFT              = "DRAFT"              // 작성 중
+	PURCHASE_STATUS_ORDERED            = "ORDERED"            // 공급업체에 발주됨
+	PURCHASE_STATUS_PARTIALLY_RECEIVED = "PARTIALLY_RECEIVED" // 일부 수량만 입고
+	PURCHASE_STATUS_RECEIVED           = "RECEIVED"           // 전체 수량 입고 완료
+	PURCHASE_STATUS_CANCELLED          = "CANCELLED"          // 취소
+)
+
+/* 공급업체 발주(입고 문서) 정보 저장 */
+type PurchaseOrder struct {
+	gorm.Model
+	UserID      uint       `json:"user_id"`
+	SupplierID  uint       `json:"supplier_id" binding:"required" validate:"required"`
+	WarehouseID uint       `json:"warehouse_id" binding:"required" validate:"required"` // 입고 창고
+	Status      string     `json:"status" gorm:"default:DRAFT" validate:"oneof=DRAFT ORDERED PARTIALLY_RECEIVED RECEIVED CANCELLED"`
+	Reference   string     `json:"reference" gorm:"unique"` // 입고 재고내역에 기록되는 참조 값
+	Note        string     `json:"note"`                    // 선택적 메모
+	ExpectedAt  *time.Time `json:"expected_at"`             // 입고 예정일
+	OrderedAt   *time.Time `json:"ordered_at"`
+	ReceivedAt  *time.Time `json:"received_at"`
+
+	// 연관관계
+	Supplier  *Supplier           `gorm:"foreignKey:SupplierID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Warehouse *Warehouse          `gorm:"foreignKey:WarehouseID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Lines     []PurchaseOrderLine `gorm:"foreignKey:PurchaseOrderID;constraint:OnDelete:CASCADE"` // PurchaseOrder 삭제 시 Line 삭제
+}
+
+/* 발주 항목 정보 저장 */
+type PurchaseOrderLine struct {
+	gorm.Model
+	PurchaseOrderID     uint    `json:"purchase_order_id" binding:"required" validate:"required"`
+	ProductID           uint    `json:"product_id" binding:"required" validate:"required"`
+	OrderedQuantity     int     `json:"ordered_quantity" binding:"required" validate:"required,gt=0"`
+	ReceivedQuantity    int     `json:"received_quantity" validate:"gte=0"`
+	UnitCost            float64 `json:"unit_cost" validate:"gte=0"`    // 입고 재고내역에 기록되는 매입 단가
+	OutstandingQuantity int     `json:"outstanding_quantity" gorm:"-"` // 미입고 수량 = 발주 수량 - 입고 수량
+
+	// 연관관계
+	Product *Product `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+/* 발주 항목 입고 요청 ( 저장하지 않음, 로트/일련번호 관리 제품은 해당 정보 필요 ) */
+type PurchaseOrderReceipt struct {
+	LineID         uint
+	Quantity       int
+	LotNumber      string
+	ManufacturedAt *time.Time
+	ExpiresAt      *time.Time
+	Serials        []string
+	BinLocationID  *uint
+}
+
+// 조회 시 미입고 수량 계산
+func (p *PurchaseOrderLine) AfterFind(tx *gorm.DB) error {
+	p.OutstandingQuantity = p.RemainingQuantity()
+	return nil
+}
+
+// 아직 입고되지 않은 수량
+func (p *PurchaseOrderLine) RemainingQuantity() int {
+	return p.OrderedQuantity - p.ReceivedQuantity
+}
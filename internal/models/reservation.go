@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	RESERVATION_STATUS_ACTIVE   = "ACTIVE"   // 예약 중 ( 가용 수량에서 차감 )
+	RESERVATION_STATUS_RELEASED = "RELEASED" // 수동 해제
+	RESERVATION_STATUS_EXPIRED  = "EXPIRED"  // 만료되어 자동 해제
+	RESERVATION_STATUS_CONSUMED = "CONSUMED" // 예약 주체의 출고로 소진
+)
+
+/* 재고 예약 정보 저장 ( 보유 수량은 그대로 두고 가용 수량만 차감 ) */
+type Reservation struct {
+	gorm.Model
+	InventoryID    uint      `json:"inventory_id" binding:"required" validate:"required"`
+	Quantity       int       `json:"quantity" binding:"required" validate:"required,gt=0"`
+	OwnerReference string    `json:"owner_reference" gorm:"index" binding:"required" validate:"required"` // 예약 주체 ( 예: ORDER-1 )
+	Status         string    `json:"status" gorm:"index;default:ACTIVE" validate:"oneof=ACTIVE RELEASED EXPIRED CONSUMED"`
+	ExpiresAt      time.Time `json:"expires_at" gorm:"index" binding:"required" validate:"required"`
+
+	// 연관관계
+	Inventory *Inventory `gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"` // Inventory 삭제 시 Reservation 삭제
+}
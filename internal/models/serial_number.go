@@ -0,0 +1,24 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+const (
+	SERIAL_STATUS_IN_STOCK = "IN_STOCK" // 창고에 보관 중
+	SERIAL_STATUS_OUT      = "OUT"      // 출고됨
+)
+
+/* 일련번호 관리 제품의 개별 일련번호 저장 ( 전체 창고에서 유일 ) */
+type SerialNumber struct {
+	gorm.Model
+	ProductID   uint   `json:"product_id" gorm:"index" binding:"required" validate:"required"`
+	InventoryID uint   `json:"inventory_id" gorm:"index" binding:"required" validate:"required"` // 현재(출고된 경우 마지막) 보관 재고
+	Serial      string `json:"serial" gorm:"unique" binding:"required" validate:"required"`
+	Status      string `json:"status" gorm:"default:IN_STOCK" validate:"oneof=IN_STOCK OUT"`
+
+	// 연관관계
+	Product      *Product      `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"` // Product 삭제 시 SerialNumber 삭제
+	Inventory    *Inventory    `gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"`                // Inventory 삭제 시 SerialNumber 삭제
+	Transactions []Transaction `json:"transactions,omitempty" gorm:"many2many:transaction_serials;"`      // 입고/출고 이력
+}
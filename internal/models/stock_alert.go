@@ -0,0 +1,27 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+const (
+	STOCK_ALERT_BELOW_MIN = "BELOW_MIN" // 최소 수량 미만
+	STOCK_ALERT_REORDER   = "REORDER"   // 재주문점 이하
+	STOCK_ALERT_ABOVE_MAX = "ABOVE_MAX" // 최대 수량 초과
+)
+
+/* 재고 수량이 임계치를 넘어선 시점의 알림 저장 */
+type StockAlert struct {
+	gorm.Model
+	InventoryID   uint   `json:"inventory_id" gorm:"index" binding:"required" validate:"required"`
+	WarehouseID   uint   `json:"warehouse_id" gorm:"index" binding:"required" validate:"required"`
+	ProductID     uint   `json:"product_id" binding:"required" validate:"required"`
+	TransactionID uint   `json:"transaction_id"` // 알림을 발생시킨 재고내역
+	Level         string `json:"level" gorm:"index" validate:"oneof=BELOW_MIN REORDER ABOVE_MAX"`
+	Quantity      int    `json:"quantity"`  // 알림 발생 시점의 재고 수량
+	Threshold     int    `json:"threshold"` // 넘어선 임계치
+	Acknowledged  bool   `json:"acknowledged" gorm:"default:false"`
+
+	// 연관관계
+	Inventory *Inventory `gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"` // Inventory 삭제 시 StockAlert 삭제
+}
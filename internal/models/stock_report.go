@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+)
+
+/* 재고별 시점 수량 ( 저장하지 않음 ) */
+type StockReportItem struct {
+	InventoryID uint   `json:"inventory_id"`
+	ProductID   uint   `json:"product_id"`
+	ProductName string `json:"product_name"`
+	SKU         string `json:"sku"`
+	Quantity    int    `json:"quantity"`
+}
+
+/* 창고별 시점 수량 합계 ( 저장하지 않음 ) */
+type WarehouseStock struct {
+	WarehouseID   uint              `json:"warehouse_id"`
+	WarehouseName string            `json:"warehouse_name"`
+	Items         []StockReportItem `json:"items"`
+	TotalQuantity int               `json:"total_quantity"`
+}
+
+/* 시점 재고 보고서 ( 저장하지 않음 ) */
+type StockReport struct {
+	AsOf          time.Time        `json:"as_of"`
+	Warehouses    []WarehouseStock `json:"warehouses"`
+	TotalQuantity int              `json:"total_quantity"`
+}
+
+// 재고내역을 반영된 순서대로 다시 반영한 수량 ( 0 에서 시작, ADJUST 는 해당 수량으로 재설정 )
+func ReplayQuantity(transactions []Transaction) int {
+	var inventory Inventory
+	for _, transaction := range transactions {
+		inventory.Quantity = inventory.NextQuantity(transaction.Type, transaction.Quantity)
+	}
+
+	return inventory.Quantity
+}
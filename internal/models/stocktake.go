@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	STOCKTAKE_STATUS_OPEN      = "OPEN"      // 실사 진행 중 ( 실사 수량 입력 가능 )
+	STOCKTAKE_STATUS_APPROVED  = "APPROVED"  // 승인되어 차이 수량이 조정(ADJUST)으로 반영됨
+	STOCKTAKE_STATUS_CANCELLED = "CANCELLED" // 취소
+)
+
+/* 창고(또는 보관 위치 범위) 단위의 재고 실사 정보 저장 */
+type Stocktake struct {
+	gorm.Model
+	UserID        uint       `json:"user_id"`
+	WarehouseID   uint       `json:"warehouse_id" binding:"required" validate:"required"`
+	BinCodePrefix string     `json:"bin_code_prefix"` // 보관 위치 코드 접두어 ( 비어 있으면 창고 전체 재고 )
+	Status        string     `json:"status" gorm:"default:OPEN" validate:"oneof=OPEN APPROVED CANCELLED"`
+	Reference     string     `json:"reference" gorm:"unique"` // 조정 재고내역에 기록되는 참조 값
+	Note          string     `json:"note"`                    // 선택적 메모
+	ApprovedBy    uint       `json:"approved_by"`
+	ApprovedAt    *time.Time `json:"approved_at"`
+
+	// 연관관계
+	Warehouse *Warehouse      `gorm:"foreignKey:WarehouseID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Lines     []StocktakeLine `gorm:"foreignKey:StocktakeID;constraint:OnDelete:CASCADE"` // Stocktake 삭제 시 Line 삭제
+}
+
+/* 실사 항목 정보 저장 ( 예상 수량은 실사 시작 시점에 고정 ) */
+type StocktakeLine struct {
+	gorm.Model
+	StocktakeID      uint  `json:"stocktake_id" gorm:"index" binding:"required" validate:"required"`
+	InventoryID      uint  `json:"inventory_id" binding:"required" validate:"required"`
+	BinLocationID    *uint `json:"bin_location_id"`   // 보관 위치 범위 실사인 경우 위치
+	ExpectedQuantity int   `json:"expected_quantity"` // 실사 시작 시점의 수량
+	CountedQuantity  *int  `json:"counted_quantity"`  // 실사 수량 ( 입력 전에는 null )
+	Variance         int   `json:"variance" gorm:"-"` // 차이 수량 = 실사 수량 - 예상 수량
+
+	// 연관관계
+	Inventory   *Inventory   `gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"`
+	BinLocation *BinLocation `gorm:"foreignKey:BinLocationID;constraint:OnDelete:CASCADE"`
+}
+
+/* 실사 차이 보고서 ( 저장하지 않음 ) */
+type StocktakeVarianceReport struct {
+	StocktakeID      uint            `json:"stocktake_id"`
+	Reference        string          `json:"reference"`
+	Status           string          `json:"status"`
+	TotalLines       int             `json:"total_lines"`
+	CountedLines     int             `json:"counted_lines"`
+	DiscrepancyLines int             `json:"discrepancy_lines"`
+	NetVariance      int             `json:"net_variance"` // 전체 차이 수량 합계
+	Lines            []StocktakeLine `json:"lines"`        // 차이가 있는 항목
+}
+
+// 조회 시 차이 수량 계산
+func (s *StocktakeLine) AfterFind(tx *gorm.DB) error {
+	if s.CountedQuantity != nil {
+		s.Variance = *s.CountedQuantity - s.ExpectedQuantity
+	}
+	return nil
+}
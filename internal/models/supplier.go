@@ -0,0 +1,19 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+/* 공급업체 정보 저장 */
+type Supplier struct {
+	gorm.Model
+	Name        string `json:"name" gorm:"unique" binding:"required" validate:"required"`
+	ContactName string `json:"contact_name"` // 담당자 이름
+	Email       string `json:"email"`
+	Phone       string `json:"phone"`
+	Address     string `json:"address"`
+	Note        string `json:"note"` // 선택적 메모
+
+	// 연관관계
+	PurchaseOrders []PurchaseOrder `json:"purchase_orders,omitempty" gorm:"foreignKey:SupplierID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"` // Supplier 삭제 시 PurchaseOrder 삭제
+}
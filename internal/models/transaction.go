@@ -11,9 +11,70 @@ type Transaction struct {
 	gorm.Model
 	InventoryID uint      `json:"inventory_id" binding:"required" validate:"required"`
 	Type        string    `json:"type" binding:"required" validate:"required,oneof=in out adjust"` // 입고(IN), 출고(OUT), 조정(ADJUST)
-	Quantity    int       `json:"quantity" binding:"required" validate:"required"`
+	Quantity    int       `json:"quantity" binding:"required" validate:"required"`                 // 기본 단위 수량
 	Timestamp   time.Time `json:"timestamp" binding:"required" validate:"required"`
+	Reference   string    `json:"reference" gorm:"index"`     // 연관 문서 참조 ( 예: ORDER-1 )
+	Note        string    `json:"note,omitempty"`             // 시스템이 생성한 재고내역의 사유 ( 예: 재고내역 불일치 보정 )
+	Warning     string    `json:"warning,omitempty" gorm:"-"` // 음수 재고 경고 ( 저장하지 않음 )
+
+	QuantityBefore int `json:"quantity_before"` // 반영 전 재고 수량 ( 조정(ADJUST) 취소 시 변경량 계산에 사용 )
+
+	// 취소 정보 ( 재고내역은 삭제하지 않고 반대 방향의 재고내역으로 취소 )
+	ReversalOfID *uint      `json:"reversal_of_id,omitempty" gorm:"index"` // 취소 대상 재고내역 ( 취소로 생성된 재고내역인 경우 )
+	ReversedAt   *time.Time `json:"reversed_at,omitempty"`                 // 취소된 시각 ( 취소된 재고내역인 경우 )
+
+	// 요청 시 지정한 단위와 해당 단위 수량 ( 미지정 시 빈 값, Quantity 는 기본 단위로 환산되어 저장 )
+	Unit         string `json:"unit,omitempty"`
+	UnitQuantity int    `json:"unit_quantity,omitempty"`
+
+	StockAlert *StockAlert `json:"stock_alert,omitempty" gorm:"-"` // 이 재고내역으로 발생한 임계치 알림
+
+	// 입고/조정 시 로트 정보 ( 로트 관리 제품은 필수, 저장 결과는 Lots 에 기록 )
+	LotNumber      string     `json:"lot_number,omitempty" gorm:"-"`
+	ManufacturedAt *time.Time `json:"manufactured_at,omitempty" gorm:"-"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty" gorm:"-"`
+
+	BinLocationID *uint `json:"bin_location_id,omitempty" gorm:"index"` // 입고/출고 보관 위치 ( 선택 )
+
+	// 원가 ( IN: 입고 단가, OUT/조정 감소: 계산된 출고 단가와 매출원가 )
+	UnitCost    float64 `json:"unit_cost"`
+	CostOfGoods float64 `json:"cost_of_goods"`
+
+	// 입고/출고 시 일련번호 목록 ( 일련번호 관리 제품은 수량만큼 필수 )
+	Serials []string `json:"serials,omitempty" gorm:"-"`
 
 	// 연관관계
-	Inventory *Inventory `gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"` // Inventory 삭제 시 Transaction 삭제
+	Inventory     *Inventory       `gorm:"foreignKey:InventoryID;constraint:OnDelete:CASCADE"`                         // Inventory 삭제 시 Transaction 삭제
+	Lots          []TransactionLot `json:"lots,omitempty" gorm:"foreignKey:TransactionID;constraint:OnDelete:CASCADE"` // 입고/소진된 로트
+	SerialNumbers []SerialNumber   `json:"serial_numbers,omitempty" gorm:"many2many:transaction_serials;"`             // 입고/출고된 일련번호
+}
+
+// 이 재고내역으로 변경된 재고 수량 ( 증가: 양수, 감소: 음수 )
+func (t *Transaction) QuantityDelta() int {
+	switch t.Type {
+	case "IN":
+		return t.Quantity
+	case "OUT":
+		return -t.Quantity
+	case "ADJUST":
+		return t.Quantity - t.QuantityBefore
+	}
+
+	return 0
+}
+
+// 목록 정렬 컬럼의 값 ( 다음 페이지 cursor 생성에 사용 )
+func (t *Transaction) SortValue(field string) interface{} {
+	switch field {
+	case "timestamp":
+		return t.Timestamp
+	case "type":
+		return t.Type
+	case "quantity":
+		return t.Quantity
+	case "created_at":
+		return t.CreatedAt
+	}
+
+	return t.ID
 }
@@ -0,0 +1,35 @@
+package models
+
+const (
+	BATCH_MODE_ATOMIC      = "ATOMIC"      // 하나라도 실패하면 전체 취소
+	BATCH_MODE_BEST_EFFORT = "BEST_EFFORT" // 실패한 항목만 제외하고 반영
+)
+
+/* 일괄 재고내역의 항목별 처리 결과 ( 저장하지 않음 ) */
+type TransactionBatchLineResult struct {
+	Index       int          `json:"index"`  // 요청 목록에서의 순서 ( 0부터 )
+	Status      int          `json:"status"` // 항목별 HTTP 상태 코드
+	Transaction *Transaction `json:"transaction,omitempty"`
+	Error       string       `json:"error,omitempty"`
+}
+
+/* 일괄 재고내역 처리 결과 ( 저장하지 않음 ) */
+type TransactionBatchResult struct {
+	Reference string                       `json:"reference"` // 일괄 처리된 재고내역의 공통 참조 값
+	Mode      string                       `json:"mode"`
+	Succeeded int                          `json:"succeeded"`
+	Failed    int                          `json:"failed"`
+	Results   []TransactionBatchLineResult `json:"results"`
+}
+
+// 반영된 재고내역 목록
+func (r *TransactionBatchResult) Transactions() []Transaction {
+	var transactions []Transaction
+	for _, result := range r.Results {
+		if result.Transaction != nil {
+			transactions = append(transactions, *result.Transaction)
+		}
+	}
+
+	return transactions
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	TRANSFER_STATUS_DRAFT              = "DRAFT"              // 작성 중
+	TRANSFER_STATUS_IN_TRANSIT         = "IN_TRANSIT"         // 출발 창고에서 출고되어 이동 중
+	TRANSFER_STATUS_PARTIALLY_RECEIVED = "PARTIALLY_RECEIVED" // 일부 수량만 도착 창고에 입고
+	TRANSFER_STATUS_RECEIVED           = "RECEIVED"           // 전체 수량 입고 완료
+	TRANSFER_STATUS_CANCELLED          = "CANCELLED"          // 취소
+)
+
+/* 창고 간 이동 지시 정보 저장 */
+type TransferOrder struct {
+	gorm.Model
+	UserID                 uint       `json:"user_id"`
+	SourceWarehouseID      uint       `json:"source_warehouse_id" binding:"required" validate:"required"`
+	DestinationWarehouseID uint       `json:"destination_warehouse_id" binding:"required" validate:"required"`
+	Status                 string     `json:"status" gorm:"default:DRAFT" validate:"oneof=DRAFT IN_TRANSIT PARTIALLY_RECEIVED RECEIVED CANCELLED"`
+	Reference              string     `json:"reference" gorm:"unique"` // 출고/입고 재고내역에 공통으로 기록되는 참조 값
+	Note                   string     `json:"note"`                    // 선택적 메모
+	DispatchedAt           *time.Time `json:"dispatched_at"`
+	ReceivedAt             *time.Time `json:"received_at"`
+
+	// 연관관계
+	SourceWarehouse      *Warehouse          `gorm:"foreignKey:SourceWarehouseID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	DestinationWarehouse *Warehouse          `gorm:"foreignKey:DestinationWarehouseID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Items                []TransferOrderItem `gorm:"foreignKey:TransferOrderID;constraint:OnDelete:CASCADE"` // TransferOrder 삭제 시 Item 삭제
+}
+
+/* 이동 지시 항목 정보 저장 */
+type TransferOrderItem struct {
+	gorm.Model
+	TransferOrderID  uint    `json:"transfer_order_id" binding:"required" validate:"required"`
+	ProductID        uint    `json:"product_id" binding:"required" validate:"required"`
+	Quantity         int     `json:"quantity" binding:"required" validate:"required,gt=0"`
+	ReceivedQuantity int     `json:"received_quantity" validate:"gte=0"`
+	UnitCost         float64 `json:"unit_cost"` // 출고 시 계산된 단가 ( 도착 창고 입고 단가로 사용 )
+
+	// 연관관계
+	Product *Product `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+/* 제품별 이동 중 수량 ( 대시보드 조회용 ) */
+type InTransitQuantity struct {
+	ProductID uint `json:"product_id"`
+	Quantity  int  `json:"quantity"`
+}
+
+/* 이동 지시 항목 입고 요청 ( 저장하지 않음, 일련번호 관리 제품은 출고된 일련번호 필요 ) */
+type TransferOrderReceipt struct {
+	ItemID    uint
+	Quantity  int
+	LotNumber string // 입고할 출고 로트 ( 미지정 시 출고된 로트 순서대로 입고, 로트 정보는 출고된 로트 그대로 사용 )
+	Serials   []string
+}
+
+// 아직 도착 창고에 입고되지 않은 수량
+func (t *TransferOrderItem) RemainingQuantity() int {
+	return t.Quantity - t.ReceivedQuantity
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+)
+
+// 휴지통에서 조회/복원할 수 있는 유형
+const (
+	TRASH_TYPE_WAREHOUSES   = "warehouses"
+	TRASH_TYPE_PRODUCTS     = "products"
+	TRASH_TYPE_INVENTORIES  = "inventories"
+	TRASH_TYPE_TRANSACTIONS = "transactions"
+)
+
+/* 삭제된(soft delete) 레코드 목록 ( 저장하지 않음 ) */
+type Trash struct {
+	Warehouses   []Warehouse   `json:"warehouses,omitempty"`
+	Products     []Product     `json:"products,omitempty"`
+	Inventories  []Inventory   `json:"inventories,omitempty"`
+	Transactions []Transaction `json:"transactions,omitempty"`
+}
+
+/* 휴지통 유형별 건수 ( 저장하지 않음 ) */
+type TrashCount struct {
+	Warehouses   int `json:"warehouses"`
+	Products     int `json:"products"`
+	Inventories  int `json:"inventories"`
+	Transactions int `json:"transactions"`
+}
+
+/* 휴지통 영구 삭제 결과 ( 저장하지 않음 ) */
+type TrashPurgeResult struct {
+	Before  time.Time  `json:"before"`  // 이 시각 이전에 삭제된 레코드를 영구 삭제
+	Purged  TrashCount `json:"purged"`  // 영구 삭제한 건수
+	Skipped TrashCount `json:"skipped"` // 다른 데이터가 참조하고 있거나 재고내역이라 삭제하지 않은 건수
+}
@@ -4,11 +4,33 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	NEGATIVE_STOCK_FORBID = "FORBID" // 재고가 음수가 되는 출고 거부
+	NEGATIVE_STOCK_WARN   = "WARN"   // 출고는 허용하되 경고 반환
+	NEGATIVE_STOCK_ALLOW  = "ALLOW"  // 출고 허용
+)
+
 /* 창고 정보 저장 */
 type Warehouse struct {
 	gorm.Model
-	Name        string      `json:"name" binding:"required" validate:"required"`
-	Location    string      `json:"location" binding:"required" validate:"required"`
-	Inventories []Inventory `gorm:"foreignKey:WarehouseID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"` // Warehouse 삭제 시 Inventory 삭제
+	Name                string      `json:"name" binding:"required" validate:"required"`
+	Location            string      `json:"location" binding:"required" validate:"required"`
+	NegativeStockPolicy string      `json:"negative_stock_policy" gorm:"default:FORBID" validate:"oneof=FORBID WARN ALLOW"` // 음수 재고 정책
+	Inventories         []Inventory `gorm:"foreignKey:WarehouseID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`            // Warehouse 삭제 시 Inventory 삭제
 }
 
+// 목록 정렬 컬럼의 값 ( 다음 페이지 cursor 생성에 사용 )
+func (w *Warehouse) SortValue(field string) interface{} {
+	switch field {
+	case "name":
+		return w.Name
+	case "location":
+		return w.Location
+	case "created_at":
+		return w.CreatedAt
+	case "updated_at":
+		return w.UpdatedAt
+	}
+
+	return w.ID
+}
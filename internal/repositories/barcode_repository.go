@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type BarcodeRepository interface {
+	FindAll(search_filter map[string]interface{}) ([]models.Barcode, error)
+	FindByID(id uint) (*models.Barcode, error)
+	FindByCode(code string) (*models.Barcode, error)
+	Create(barcode *models.Barcode) (*models.Barcode, error)
+	Delete(id uint) error
+}
+
+type barcodeRepository struct {
+	db *gorm.DB
+}
+
+func NewBarcodeRepository(db *gorm.DB) BarcodeRepository {
+	return &barcodeRepository{
+		db: db,
+	}
+}
+
+func (r *barcodeRepository) FindAll(search_filter map[string]interface{}) ([]models.Barcode, error) {
+	var barcodes []models.Barcode
+	query := r.db
+
+	for key, value := range search_filter {
+		switch key {
+		case "product_id":
+			query = query.Where("product_id = ?", value)
+		case "symbology":
+			query = query.Where("symbology = ?", value)
+		case "code":
+			query = query.Where("code LIKE ?", "%"+value.(string)+"%")
+		}
+	}
+
+	if err := query.Order("id ASC").Find(&barcodes).Error; err != nil {
+		return nil, err
+	}
+
+	return barcodes, nil
+}
+
+func (r *barcodeRepository) FindByID(id uint) (*models.Barcode, error) {
+	var barcode models.Barcode
+
+	if err := r.db.Preload("Product").First(&barcode, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &barcode, nil
+}
+
+// 바코드 값으로 조회 ( 환산 단위 확인을 위해 제품 단위도 함께 조회 )
+func (r *barcodeRepository) FindByCode(code string) (*models.Barcode, error) {
+	var barcode models.Barcode
+
+	if err := r.db.Preload("Product").Preload("Product.Units").Where("code = ?", code).First(&barcode).Error; err != nil {
+		return nil, err
+	}
+
+	return &barcode, nil
+}
+
+func (r *barcodeRepository) Create(barcode *models.Barcode) (*models.Barcode, error) {
+	if err := r.db.Create(barcode).Error; err != nil {
+		return nil, err
+	}
+
+	return barcode, nil
+}
+
+// 삭제된 바코드 값을 다시 등록할 수 있도록 완전 삭제
+func (r *barcodeRepository) Delete(id uint) error {
+	return r.db.Unscoped().Delete(&models.Barcode{}, id).Error
+}
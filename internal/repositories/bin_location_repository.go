@@ -0,0 +1,144 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BinLocationRepository interface {
+	FindAll(search_filter map[string]interface{}) ([]models.BinLocation, error)
+	FindByID(id uint) (*models.BinLocation, error)
+	Create(binLocation *models.BinLocation) (*models.BinLocation, error)
+	Delete(id uint) error
+	GetStockQuantity(binLocationID uint) (int64, error)
+	FindStocksByBinLocationIDs(binLocationIDs []uint) ([]models.BinStock, error)
+	FindStockForUpdate(inventoryID, binLocationID uint) (*models.BinStock, error)
+	AddStockQuantity(inventoryID, binLocationID uint, delta int) error
+
+	WithTx(tx *gorm.DB) BinLocationRepository
+	Transaction(fn func(tx *gorm.DB) error) error
+}
+
+type binLocationRepository struct {
+	db *gorm.DB
+}
+
+func NewBinLocationRepository(db *gorm.DB) BinLocationRepository {
+	return &binLocationRepository{
+		db: db,
+	}
+}
+
+func (r *binLocationRepository) FindAll(search_filter map[string]interface{}) ([]models.BinLocation, error) {
+	var binLocations []models.BinLocation
+	query := r.db
+
+	for key, value := range search_filter {
+		switch key {
+		case "warehouse_id":
+			query = query.Where("warehouse_id = ?", value)
+		case "zone":
+			query = query.Where("zone = ?", value)
+		case "aisle":
+			query = query.Where("aisle = ?", value)
+		case "code":
+			query = query.Where("code LIKE ?", "%"+value.(string)+"%")
+		case "code_prefix":
+			query = query.Where("code LIKE ?", value.(string)+"%")
+		}
+	}
+
+	if err := query.Order("code ASC").Find(&binLocations).Error; err != nil {
+		return nil, err
+	}
+
+	return binLocations, nil
+}
+
+// 보관 위치와 위치에 보관 중인 재고 조회
+func (r *binLocationRepository) FindByID(id uint) (*models.BinLocation, error) {
+	var binLocation models.BinLocation
+
+	if err := r.db.Preload("Warehouse").
+		Preload("BinStocks", "quantity > 0").
+		Preload("BinStocks.Inventory").
+		Preload("BinStocks.Inventory.Product").
+		First(&binLocation, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &binLocation, nil
+}
+
+func (r *binLocationRepository) Create(binLocation *models.BinLocation) (*models.BinLocation, error) {
+	if err := r.db.Create(binLocation).Error; err != nil {
+		return nil, err
+	}
+
+	return binLocation, nil
+}
+
+func (r *binLocationRepository) Delete(id uint) error {
+	return r.db.Delete(&models.BinLocation{}, id).Error
+}
+
+// 보관 위치에 남아 있는 전체 수량
+func (r *binLocationRepository) GetStockQuantity(binLocationID uint) (int64, error) {
+	var quantity int64
+
+	if err := r.db.Model(&models.BinStock{}).
+		Where("bin_location_id = ?", binLocationID).
+		Select("COALESCE(SUM(quantity), 0)").
+		Scan(&quantity).Error; err != nil {
+		return 0, err
+	}
+
+	return quantity, nil
+}
+
+// 보관 위치 목록에 보관된 재고 수량 조회
+func (r *binLocationRepository) FindStocksByBinLocationIDs(binLocationIDs []uint) ([]models.BinStock, error) {
+	var binStocks []models.BinStock
+
+	if err := r.db.Where("bin_location_id IN ?", binLocationIDs).Order("bin_location_id ASC, inventory_id ASC").Find(&binStocks).Error; err != nil {
+		return nil, err
+	}
+
+	return binStocks, nil
+}
+
+// 재고-보관 위치 수량을 잠금 후 조회 ( 없으면 수량 0으로 생성 )
+func (r *binLocationRepository) FindStockForUpdate(inventoryID, binLocationID uint) (*models.BinStock, error) {
+	binStock := models.BinStock{
+		InventoryID:   inventoryID,
+		BinLocationID: binLocationID,
+	}
+
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("inventory_id = ? AND bin_location_id = ?", inventoryID, binLocationID).
+		FirstOrCreate(&binStock).Error; err != nil {
+		return nil, err
+	}
+
+	return &binStock, nil
+}
+
+// 재고-보관 위치 수량 증감
+func (r *binLocationRepository) AddStockQuantity(inventoryID, binLocationID uint, delta int) error {
+	return r.db.Model(&models.BinStock{}).
+		Where("inventory_id = ? AND bin_location_id = ?", inventoryID, binLocationID).
+		Update("quantity", gorm.Expr("quantity + ?", delta)).Error
+}
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *binLocationRepository) WithTx(tx *gorm.DB) BinLocationRepository {
+	return &binLocationRepository{
+		db: tx,
+	}
+}
+
+func (r *binLocationRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type CategoryRepository interface {
+	FindAll(search_filter map[string]interface{}) ([]models.Category, error)
+	FindByID(id uint) (*models.Category, error)
+	FindByParentAndName(parentID *uint, name string) (*models.Category, error)
+	Create(category *models.Category) (*models.Category, error)
+	Delete(id uint) error
+}
+
+type categoryRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) CategoryRepository {
+	return &categoryRepository{
+		db: db,
+	}
+}
+
+func (r *categoryRepository) FindAll(search_filter map[string]interface{}) ([]models.Category, error) {
+	var categories []models.Category
+	query := r.db
+
+	for key, value := range search_filter {
+		switch key {
+		case "name":
+			query = query.Where("name LIKE ?", "%"+value.(string)+"%")
+		case "parent_id":
+			query = query.Where("parent_id = ?", value)
+		}
+	}
+
+	if err := query.Order("id ASC").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// 분류와 바로 아래 하위 분류 조회
+func (r *categoryRepository) FindByID(id uint) (*models.Category, error) {
+	var category models.Category
+
+	if err := r.db.Preload("Children").First(&category, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+// 같은 상위 분류 아래의 이름으로 조회 ( 최상위 분류는 parentID 가 nil )
+func (r *categoryRepository) FindByParentAndName(parentID *uint, name string) (*models.Category, error) {
+	var category models.Category
+
+	query := r.db.Where("name = ?", name)
+	if parentID == nil {
+		query = query.Where("parent_id IS NULL")
+	} else {
+		query = query.Where("parent_id = ?", *parentID)
+	}
+
+	if err := query.First(&category).Error; err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (r *categoryRepository) Create(category *models.Category) (*models.Category, error) {
+	if err := r.db.Create(category).Error; err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (r *categoryRepository) Delete(id uint) error {
+	return r.db.Delete(&models.Category{}, id).Error
+}
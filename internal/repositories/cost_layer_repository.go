@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CostLayerRepository interface {
+	FindByInventoryID(inventoryID uint) ([]models.CostLayer, error)
+	FindAvailableForUpdate(inventoryID uint) ([]models.CostLayer, error)
+	Create(costLayer *models.CostLayer) (*models.CostLayer, error)
+	AddRemainingQuantity(id uint, delta int) error
+	GetRemainingValue(inventoryID uint) (int, float64, error)
+
+	WithTx(tx *gorm.DB) CostLayerRepository
+}
+
+type costLayerRepository struct {
+	db *gorm.DB
+}
+
+func NewCostLayerRepository(db *gorm.DB) CostLayerRepository {
+	return &costLayerRepository{
+		db: db,
+	}
+}
+
+// 재고의 모든 원가층 조회 ( 입고 순 )
+func (r *costLayerRepository) FindByInventoryID(inventoryID uint) ([]models.CostLayer, error) {
+	var costLayers []models.CostLayer
+
+	if err := r.db.Where("inventory_id = ?", inventoryID).Order("received_at ASC, id ASC").Find(&costLayers).Error; err != nil {
+		return nil, err
+	}
+
+	return costLayers, nil
+}
+
+// 남은 수량이 있는 원가층을 입고 순서로 잠금 후 조회
+func (r *costLayerRepository) FindAvailableForUpdate(inventoryID uint) ([]models.CostLayer, error) {
+	var costLayers []models.CostLayer
+
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("inventory_id = ? AND remaining_quantity > 0", inventoryID).
+		Order("received_at ASC, id ASC").
+		Find(&costLayers).Error; err != nil {
+		return nil, err
+	}
+
+	return costLayers, nil
+}
+
+func (r *costLayerRepository) Create(costLayer *models.CostLayer) (*models.CostLayer, error) {
+	if err := r.db.Create(costLayer).Error; err != nil {
+		return nil, err
+	}
+
+	return costLayer, nil
+}
+
+// 원가층 남은 수량 증감
+func (r *costLayerRepository) AddRemainingQuantity(id uint, delta int) error {
+	return r.db.Model(&models.CostLayer{}).
+		Where("id = ?", id).
+		Update("remaining_quantity", gorm.Expr("remaining_quantity + ?", delta)).Error
+}
+
+// 재고의 남은 원가층 수량과 금액 합계
+func (r *costLayerRepository) GetRemainingValue(inventoryID uint) (int, float64, error) {
+	var result struct {
+		Quantity int
+		Value    float64
+	}
+
+	if err := r.db.Model(&models.CostLayer{}).
+		Select("COALESCE(SUM(remaining_quantity), 0) AS quantity, COALESCE(SUM(remaining_quantity * unit_cost), 0) AS value").
+		Where("inventory_id = ? AND remaining_quantity > 0", inventoryID).
+		Scan(&result).Error; err != nil {
+		return 0, 0, err
+	}
+
+	return result.Quantity, result.Value, nil
+}
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *costLayerRepository) WithTx(tx *gorm.DB) CostLayerRepository {
+	return &costLayerRepository{
+		db: tx,
+	}
+}
@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+
+	"time"
+)
+
+type IdempotencyKeyRepository interface {
+	FindByKey(userID uint, key string) (*models.IdempotencyKey, error)
+	Create(idempotencyKey *models.IdempotencyKey) (*models.IdempotencyKey, error)
+	Refresh(id uint, expiresAt time.Time) (bool, error)
+	SaveResponse(id uint, statusCode int, contentType string, body []byte, expiresAt time.Time) (bool, error)
+	DeleteExpired(id uint, statusCode int, now time.Time) (bool, error)
+	Delete(id uint) error
+}
+
+type idempotencyKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyKeyRepository(db *gorm.DB) IdempotencyKeyRepository {
+	return &idempotencyKeyRepository{
+		db: db,
+	}
+}
+
+func (r *idempotencyKeyRepository) FindByKey(userID uint, key string) (*models.IdempotencyKey, error) {
+	var idempotencyKey models.IdempotencyKey
+
+	if err := r.db.Where("user_id = ? AND key = ?", userID, key).First(&idempotencyKey).Error; err != nil {
+		return nil, err
+	}
+
+	return &idempotencyKey, nil
+}
+
+// 처리 중 상태로 키 등록 ( 같은 키가 이미 있으면 고유 인덱스 오류 )
+func (r *idempotencyKeyRepository) Create(idempotencyKey *models.IdempotencyKey) (*models.IdempotencyKey, error) {
+	if err := r.db.Create(idempotencyKey).Error; err != nil {
+		return nil, err
+	}
+
+	return idempotencyKey, nil
+}
+
+// 처리 중인 키의 보관 기한 연장 ( 이미 응답을 저장했거나 삭제된 경우 false 반환 )
+func (r *idempotencyKeyRepository) Refresh(id uint, expiresAt time.Time) (bool, error) {
+	result := r.db.Model(&models.IdempotencyKey{}).
+		Where("id = ? AND status_code = ?", id, 0).
+		Update("expires_at", expiresAt)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// 처리가 끝난 요청의 응답과 보관 기한 저장 ( 처리 중 상태의 키가 아닌 경우 false 반환 )
+func (r *idempotencyKeyRepository) SaveResponse(id uint, statusCode int, contentType string, body []byte, expiresAt time.Time) (bool, error) {
+	result := r.db.Model(&models.IdempotencyKey{}).
+		Where("id = ? AND status_code = ?", id, 0).
+		Updates(map[string]interface{}{
+			"status_code":   statusCode,
+			"content_type":  contentType,
+			"response_body": body,
+			"expires_at":    expiresAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// 조회한 상태 그대로 보관 기한이 지난 키만 삭제 ( 그 사이 기한이 연장되었거나 응답이 저장된 경우 false 반환 )
+func (r *idempotencyKeyRepository) DeleteExpired(id uint, statusCode int, now time.Time) (bool, error) {
+	result := r.db.Unscoped().
+		Where("id = ? AND status_code = ? AND expires_at < ?", id, statusCode, now).
+		Delete(&models.IdempotencyKey{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// 키 삭제 ( 같은 키를 다시 사용할 수 있도록 고유 인덱스에서도 제거 )
+func (r *idempotencyKeyRepository) Delete(id uint) error {
+	return r.db.Unscoped().Delete(&models.IdempotencyKey{}, id).Error
+}
@@ -4,20 +4,40 @@ import (
 	"github.com/jhphon0730/StockFlow/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"errors"
+	"fmt"
+	"strconv"
 	"time"
 )
 
 type InventoryRepository interface {
 	FindAll(search_filter map[string]interface{}) ([]models.Inventory, error)
+	FindPage(search_filter map[string]interface{}, page models.PageQuery) ([]models.Inventory, *models.Pagination, error)
+	FindAllAsOf(search_filter map[string]interface{}, asOf time.Time) ([]models.Inventory, error)
+	FindPageAsOf(search_filter map[string]interface{}, page models.PageQuery, asOf time.Time) ([]models.Inventory, *models.Pagination, error)
 	FindByID(id uint) (*models.Inventory, error)
+	FindByIDForUpdate(id uint) (*models.Inventory, error)
+	FindByWarehouseAndProduct(warehouseID, productID uint) (*models.Inventory, error)
+	FindOrCreate(warehouseID, productID uint) (*models.Inventory, error)
+	FindWarehouseIDs(ids []uint) (map[uint]uint, error)
 	Create(inventory *models.Inventory) (*models.Inventory, error)
 	Delete(id uint) error
-	UpdateQuantity(id uint, quantity int, transaction_type string) error
+	UpdateQuantity(id uint, quantity, version int) error
+	UpdateReservedQuantity(id uint, delta int) error
+	UpdateThresholds(id uint, minQuantity, reorderPoint, maxQuantity int) error
+	UpdateThresholdsIfValid(id uint, thresholds map[string]int) (bool, error)
+	UpdateAverageCost(id uint, averageCost float64) error
 	GetCountWithComparison() (int64, float64, error)
 	GetZeroQuantityInventory() (int64, error)
+
+	WithTx(tx *gorm.DB) InventoryRepository
 }
 
+// 재고 수량 변경 시 조회 이후 다른 요청이 먼저 수량을 변경한 경우
+var ErrInventoryVersionConflict = errors.New("재고가 동시에 변경되었습니다. 다시 시도해 주세요")
+
 type inventoryRepository struct {
 	db *gorm.DB
 }
@@ -28,8 +48,8 @@ func NewInventoryRepository(db *gorm.DB) InventoryRepository {
 	}
 }
 
-func (r *inventoryRepository) FindAll(search_filter map[string]interface{}) ([]models.Inventory, error) {
-	var inventories []models.Inventory
+// 검색 조건을 적용한 쿼리
+func (r *inventoryRepository) searchQuery(search_filter map[string]interface{}) *gorm.DB {
 	query := r.db
 
 	for key, value := range search_filter {
@@ -41,23 +61,143 @@ func (r *inventoryRepository) FindAll(search_filter map[string]interface{}) ([]m
 		}
 	}
 
+	return query
+}
+
+func (r *inventoryRepository) FindAll(search_filter map[string]interface{}) ([]models.Inventory, error) {
+	var inventories []models.Inventory
+
+	if err := r.searchQuery(search_filter).Preload("Product").Preload("Warehouse").Find(&inventories).Error; err != nil {
+		return nil, err
+	}
+
+	return inventories, nil
+}
+
+// 검색 조건에 맞는 재고를 페이지 단위로 조회
+func (r *inventoryRepository) FindPage(search_filter map[string]interface{}, page models.PageQuery) ([]models.Inventory, *models.Pagination, error) {
+	var inventories []models.Inventory
+
+	query, pagination, err := paginate(r.searchQuery(search_filter), &models.Inventory{}, "inventories", page)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if err := query.Preload("Product").Preload("Warehouse").Find(&inventories).Error; err != nil {
+		return nil, nil, err
+	}
+
+	inventories, err = trimPage(inventories, pagination, (*models.Inventory).SortValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inventories, pagination, nil
+}
+
+// 지정 시각에 삭제되지 않았던 재고를 포함한 검색 쿼리 ( 이후 삭제된 재고도 그 시점에는 존재 )
+func (r *inventoryRepository) searchQueryAsOf(search_filter map[string]interface{}, asOf time.Time) *gorm.DB {
+	return r.searchQuery(search_filter).Unscoped().Where("inventories.deleted_at IS NULL OR inventories.deleted_at > ?", asOf)
+}
+
+// 지정 시각에 존재했던 재고 중 검색 조건에 맞는 재고 조회
+func (r *inventoryRepository) FindAllAsOf(search_filter map[string]interface{}, asOf time.Time) ([]models.Inventory, error) {
+	var inventories []models.Inventory
+
+	if err := r.searchQueryAsOf(search_filter, asOf).Preload("Product").Preload("Warehouse").Find(&inventories).Error; err != nil {
 		return nil, err
 	}
 
 	return inventories, nil
 }
 
+// 지정 시각에 존재했던 재고 중 검색 조건에 맞는 재고를 페이지 단위로 조회
+func (r *inventoryRepository) FindPageAsOf(search_filter map[string]interface{}, page models.PageQuery, asOf time.Time) ([]models.Inventory, *models.Pagination, error) {
+	var inventories []models.Inventory
+
+	query, pagination, err := paginate(r.searchQueryAsOf(search_filter, asOf), &models.Inventory{}, "inventories", page)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := query.Preload("Product").Preload("Warehouse").Find(&inventories).Error; err != nil {
+		return nil, nil, err
+	}
+
+	inventories, err = trimPage(inventories, pagination, (*models.Inventory).SortValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inventories, pagination, nil
+}
+
 func (r *inventoryRepository) FindByID(id uint) (*models.Inventory, error) {
 	var inventory models.Inventory
 
-	if err := r.db.Preload("Product").Preload("Warehouse").Preload("Transactions").First(&inventory, id).Error; err != nil {
+	if err := r.db.Preload("Product").Preload("Warehouse").Preload("Transactions").Preload("Lots", func(db *gorm.DB) *gorm.DB {
+		return db.Order("expires_at IS NULL, expires_at ASC, id ASC")
+	}).Preload("BinStocks", "quantity > 0").Preload("BinStocks.BinLocation").First(&inventory, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &inventory, nil
+}
+
+// 재고 행에 잠금을 걸고 조회 ( 트랜잭션 안에서 사용 )
+func (r *inventoryRepository) FindByIDForUpdate(id uint) (*models.Inventory, error) {
+	var inventory models.Inventory
+
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Warehouse").Preload("Product").Preload("Product.Units").First(&inventory, id).Error; err != nil {
 		return nil, err
 	}
 
 	return &inventory, nil
 }
 
+func (r *inventoryRepository) FindByWarehouseAndProduct(warehouseID, productID uint) (*models.Inventory, error) {
+	var inventory models.Inventory
+
+	if err := r.db.Where("warehouse_id = ? AND product_id = ?", warehouseID, productID).First(&inventory).Error; err != nil {
+		return nil, err
+	}
+
+	return &inventory, nil
+}
+
+// 창고-제품 재고가 없으면 수량 0으로 생성
+func (r *inventoryRepository) FindOrCreate(warehouseID, productID uint) (*models.Inventory, error) {
+	inventory := models.Inventory{
+		WarehouseID: warehouseID,
+		ProductID:   productID,
+	}
+
+	if err := r.db.Where("warehouse_id = ? AND product_id = ?", warehouseID, productID).FirstOrCreate(&inventory).Error; err != nil {
+		return nil, err
+	}
+
+	return &inventory, nil
+}
+
+// 재고 ID 별 창고 ID 조회 ( 연관 데이터 없이 필요한 컬럼만 조회 )
+func (r *inventoryRepository) FindWarehouseIDs(ids []uint) (map[uint]uint, error) {
+	warehouseIDs := make(map[uint]uint, len(ids))
+	if len(ids) == 0 {
+		return warehouseIDs, nil
+	}
+
+	var inventories []models.Inventory
+	if err := r.db.Select("id", "warehouse_id").Where("id IN ?", ids).Find(&inventories).Error; err != nil {
+		return nil, err
+	}
+
+	for _, inventory := range inventories {
+		warehouseIDs[inventory.ID] = inventory.WarehouseID
+	}
+
+	return warehouseIDs, nil
+}
+
 func (r *inventoryRepository) Create(inventory *models.Inventory) (*models.Inventory, error) {
 	if err := r.db.Create(inventory).Error; err != nil {
 		return nil, err
@@ -90,33 +230,81 @@ func (r *inventoryRepository) Delete(id uint) error {
 	return nil
 }
 
-func (r *inventoryRepository) UpdateQuantity(id uint, quantity int, transaction_type string) error {
-	var inventory *models.Inventory
-	tx := r.db.Begin()
-	if err := tx.Error; err != nil {
-		return err
+// 조회한 버전이 그대로인 경우에만 수량 변경 후 버전 증가 ( 그 사이 다른 요청이 변경했으면 ErrInventoryVersionConflict )
+func (r *inventoryRepository) UpdateQuantity(id uint, quantity, version int) error {
+	result := r.db.Model(&models.Inventory{}).
+		Where("id = ? AND version = ?", id, version).
+		Updates(map[string]interface{}{
+			"quantity": quantity,
+			"version":  gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return result.Error
 	}
 
-	if err := tx.First(&inventory, id).Error; err != nil {
-		tx.Rollback()
-		return err
+	if result.RowsAffected == 0 {
+		return ErrInventoryVersionConflict
 	}
 
-	switch transaction_type {
-	case "IN":
-	inventory.Quantity += quantity
-	case "OUT":
-	inventory.Quantity -= quantity
-	case "ADJUST":
-	inventory.Quantity = quantity
+	return nil
+}
+
+// 예약 수량 증감 ( 예약 생성 시 +, 해제 시 - )
+func (r *inventoryRepository) UpdateReservedQuantity(id uint, delta int) error {
+	return r.db.Model(&models.Inventory{}).
+		Where("id = ?", id).
+		Update("reserved_quantity", gorm.Expr("reserved_quantity + ?", delta)).Error
+}
+
+// 최소 수량, 재주문점, 최대 수량 변경 ( 0 값도 저장되도록 map 사용 )
+func (r *inventoryRepository) UpdateThresholds(id uint, minQuantity, reorderPoint, maxQuantity int) error {
+	return r.db.Model(&models.Inventory{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"min_quantity":  minQuantity,
+			"reorder_point": reorderPoint,
+			"max_quantity":  maxQuantity,
+		}).Error
+}
+
+// 입력한 임계치만 변경하되 변경 후 임계치가 유효한 경우에만 한 번의 UPDATE 로 반영 ( 조건에 맞지 않거나 재고가 없으면 false 반환 )
+// - 유효 조건: 0 은 미설정, 설정된 값끼리 최소 수량 <= 재주문점 <= 최대 수량
+func (r *inventoryRepository) UpdateThresholdsIfValid(id uint, thresholds map[string]int) (bool, error) {
+	updates := make(map[string]interface{}, len(thresholds))
+	merged := make(map[string]string, 3)
+	for _, column := range []string{"min_quantity", "reorder_point", "max_quantity"} {
+		merged[column] = column
+		if value, ok := thresholds[column]; ok {
+			updates[column] = value
+			merged[column] = strconv.Itoa(value)
+		}
+	}
+	if len(updates) == 0 {
+		return false, errors.New("변경할 임계치가 없습니다")
 	}
 
-	if err := tx.Save(inventory).Error; err != nil {
-		tx.Rollback()
-		return err
+	minQuantity, reorderPoint, maxQuantity := merged["min_quantity"], merged["reorder_point"], merged["max_quantity"]
+	valid := fmt.Sprintf(
+		"NOT (%[1]s > 0 AND %[2]s > 0 AND %[1]s > %[2]s) AND NOT (%[3]s > 0 AND (%[1]s > %[3]s OR %[2]s > %[3]s))",
+		minQuantity, reorderPoint, maxQuantity,
+	)
+
+	result := r.db.Model(&models.Inventory{}).
+		Where("id = ?", id).
+		Where(valid).
+		Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
 	}
 
-	return tx.Commit().Error
+	return result.RowsAffected > 0, nil
+}
+
+// 보유 수량의 단위 원가 변경
+func (r *inventoryRepository) UpdateAverageCost(id uint, averageCost float64) error {
+	return r.db.Model(&models.Inventory{}).
+		Where("id = ?", id).
+		Update("average_cost", averageCost).Error
 }
 
 func (r *inventoryRepository) GetCountWithComparison() (int64, float64, error) {
@@ -162,3 +350,10 @@ func (r *inventoryRepository) GetZeroQuantityInventory() (int64, error) {
 
 	return count, nil
 }
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *inventoryRepository) WithTx(tx *gorm.DB) InventoryRepository {
+	return &inventoryRepository{
+		db: tx,
+	}
+}
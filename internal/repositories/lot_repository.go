@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"time"
+)
+
+type LotRepository interface {
+	FindByInventoryID(inventoryID uint) ([]models.Lot, error)
+	FindAvailableForUpdate(inventoryID uint) ([]models.Lot, error)
+	FindOrCreate(inventoryID uint, lotNumber string, manufacturedAt, expiresAt *time.Time) (*models.Lot, error)
+	AddQuantity(id uint, delta int) error
+	CreateTransactionLot(transactionLot *models.TransactionLot) error
+
+	WithTx(tx *gorm.DB) LotRepository
+}
+
+type lotRepository struct {
+	db *gorm.DB
+}
+
+func NewLotRepository(db *gorm.DB) LotRepository {
+	return &lotRepository{
+		db: db,
+	}
+}
+
+// 재고의 모든 로트 조회 ( 유통기한 순 )
+func (r *lotRepository) FindByInventoryID(inventoryID uint) ([]models.Lot, error) {
+	var lots []models.Lot
+
+	if err := r.db.Where("inventory_id = ?", inventoryID).Order("expires_at IS NULL, expires_at ASC, id ASC").Find(&lots).Error; err != nil {
+		return nil, err
+	}
+
+	return lots, nil
+}
+
+// 수량이 남아 있는 로트를 FEFO(유통기한이 빠른 순) 순서로 잠금 후 조회
+func (r *lotRepository) FindAvailableForUpdate(inventoryID uint) ([]models.Lot, error) {
+	var lots []models.Lot
+
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("inventory_id = ? AND quantity > 0", inventoryID).
+		Order("expires_at IS NULL, expires_at ASC, id ASC").
+		Find(&lots).Error; err != nil {
+		return nil, err
+	}
+
+	return lots, nil
+}
+
+// 재고-로트 번호에 해당하는 로트가 없으면 생성
+func (r *lotRepository) FindOrCreate(inventoryID uint, lotNumber string, manufacturedAt, expiresAt *time.Time) (*models.Lot, error) {
+	lot := models.Lot{
+		InventoryID:    inventoryID,
+		LotNumber:      lotNumber,
+		ManufacturedAt: manufacturedAt,
+		ExpiresAt:      expiresAt,
+	}
+
+	if err := r.db.Where("inventory_id = ? AND lot_number = ?", inventoryID, lotNumber).FirstOrCreate(&lot).Error; err != nil {
+		return nil, err
+	}
+
+	return &lot, nil
+}
+
+// 로트 수량 증감
+func (r *lotRepository) AddQuantity(id uint, delta int) error {
+	return r.db.Model(&models.Lot{}).
+		Where("id = ?", id).
+		Update("quantity", gorm.Expr("quantity + ?", delta)).Error
+}
+
+// 재고내역-로트 기록 생성
+func (r *lotRepository) CreateTransactionLot(transactionLot *models.TransactionLot) error {
+	return r.db.Create(transactionLot).Error
+}
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *lotRepository) WithTx(tx *gorm.DB) LotRepository {
+	return &lotRepository{
+		db: tx,
+	}
+}
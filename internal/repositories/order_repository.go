@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OrderRepository interface {
+	FindAll(search_filter map[string]interface{}) ([]models.Order, error)
+	FindByID(id uint) (*models.Order, error)
+	FindByIDForUpdate(id uint) (*models.Order, error)
+	Create(order *models.Order) (*models.Order, error)
+	UpdateStatus(id uint, from, to string) (bool, error)
+	Delete(id uint) error
+
+	WithTx(tx *gorm.DB) OrderRepository
+}
+
+type orderRepository struct {
+	db *gorm.DB
+}
+
+func NewOrderRepository(db *gorm.DB) OrderRepository {
+	return &orderRepository{
+		db: db,
+	}
+}
+
+// 모든 주문 조회
+func (r *orderRepository) FindAll(search_filter map[string]interface{}) ([]models.Order, error) {
+	var orders []models.Order
+	query := r.db
+
+	for key, value := range search_filter {
+		switch key {
+		case "warehouse_id":
+			query = query.Where("warehouse_id = ?", value)
+		case "status":
+			query = query.Where("status = ?", value)
+		case "customer":
+			query = query.Where("customer LIKE ?", "%"+value.(string)+"%")
+		}
+	}
+
+	if err := query.Preload("OrderItems").Find(&orders).Error; err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// 주문 조회
+func (r *orderRepository) FindByID(id uint) (*models.Order, error) {
+	var order models.Order
+
+	if err := r.db.Preload("Warehouse").Preload("OrderItems").Preload("OrderItems.Product").First(&order, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// 주문 행에 잠금을 걸고 주문 항목과 함께 조회 ( 트랜잭션 안에서 사용 )
+func (r *orderRepository) FindByIDForUpdate(id uint) (*models.Order, error) {
+	var order models.Order
+
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("OrderItems").First(&order, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// 주문 및 주문 항목 생성
+func (r *orderRepository) Create(order *models.Order) (*models.Order, error) {
+	if err := r.db.Create(order).Error; err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// 주문 상태를 from 에서 to 로 변경 ( 출고 완료 시 출고 시간 기록, 그 사이 상태가 바뀐 경우 false 반환 )
+func (r *orderRepository) UpdateStatus(id uint, from, to string) (bool, error) {
+	updates := map[string]interface{}{
+		"status": to,
+	}
+
+	if to == models.ORDER_STATUS_SHIPPED {
+		updates["shipped_at"] = models.GetNowTime()
+	}
+
+	result := r.db.Model(&models.Order{}).Where("id = ? AND status = ?", id, from).Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// 주문 삭제
+func (r *orderRepository) Delete(id uint) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if err := tx.Delete(&models.Order{}, id).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Where("order_id = ?", id).Delete(&models.OrderItem{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *orderRepository) WithTx(tx *gorm.DB) OrderRepository {
+	return &orderRepository{
+		db: tx,
+	}
+}
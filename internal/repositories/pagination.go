@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+
+	"fmt"
+)
+
+// 검색 조건 쿼리에 전체 건수를 구하고 정렬/페이지 조건 적용 ( 다음 페이지 확인을 위해 Size+1 건 조회 )
+// - Cursor 지정 시: 정렬 값이 cursor 레코드 다음인 레코드부터 ( keyset, 정렬 값이 같으면 ID 순 )
+// - 미지정 시: (Page-1) x Size 건 건너뜀
+// - Size 0 ( 페이지 조건 미지정 ): 정렬만 적용하고 전체 조회
+// table 과 Sort 는 코드/허용 목록의 값만 사용 ( 사용자 입력을 그대로 넣지 않음 )
+func paginate(query *gorm.DB, model interface{}, table string, page models.PageQuery) (*gorm.DB, *models.Pagination, error) {
+	pagination := &models.Pagination{
+		Size:  page.Size,
+		Sort:  page.Sort,
+		Order: page.Order,
+	}
+
+	if err := query.Session(&gorm.Session{}).Model(model).Count(&pagination.Total).Error; err != nil {
+		return nil, nil, err
+	}
+
+	comparison := ">"
+	if page.Order == models.SORT_ORDER_DESC {
+		comparison = "<"
+	}
+	column := table + "." + page.Sort
+	id := table + ".id"
+
+	if page.Cursor != nil {
+		if page.Sort == "id" {
+			query = query.Where(fmt.Sprintf("%s %s ?", id, comparison), page.Cursor.ID)
+		} else {
+			// cursor 에 담긴 마지막 레코드의 정렬 값 기준 ( 이후 변경/삭제된 레코드도 같은 위치에서 이어서 조회 )
+			query = query.Where(
+				fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", column, comparison, column, id, comparison),
+				page.Cursor.Value, page.Cursor.Value, page.Cursor.ID,
+			)
+		}
+	} else {
+		pagination.Page = page.Page
+		query = query.Offset((page.Page - 1) * page.Size)
+	}
+
+	query = query.Order(fmt.Sprintf("%s %s", column, page.Order))
+	if page.Sort != "id" {
+		query = query.Order(fmt.Sprintf("%s %s", id, page.Order))
+	}
+
+	if page.Size == 0 {
+		return query, pagination, nil
+	}
+
+	return query.Limit(page.Size + 1), pagination, nil
+}
+
+// Size+1 건 중 초과분을 잘라내고 다음 페이지 cursor 설정 ( 정렬 조건과 마지막 레코드의 정렬 값, ID 포함 )
+func trimPage[T any](items []T, pagination *models.Pagination, sortValue func(*T, string) interface{}) ([]T, error) {
+	if pagination.Size == 0 || len(items) <= pagination.Size {
+		return items, nil
+	}
+
+	items = items[:pagination.Size]
+	last := &items[len(items)-1]
+	cursor, err := models.EncodeCursor(models.Cursor{
+		Sort:  pagination.Sort,
+		Order: pagination.Order,
+		Value: sortValue(last, pagination.Sort),
+		ID:    sortValue(last, "id").(uint),
+	})
+	if err != nil {
+		return nil, err
+	}
+	pagination.NextCursor = cursor
+
+	return items, nil
+}
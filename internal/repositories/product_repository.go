@@ -10,10 +10,16 @@ import (
 
 type ProductRepository interface {
 	FindAll(search_filter map[string]interface{}) ([]models.Product, error)
+	FindPage(search_filter map[string]interface{}, page models.PageQuery) ([]models.Product, *models.Pagination, error)
 	FindByID(id uint) (*models.Product, error)
+	FindBySKU(sku string) (*models.Product, error)
+	ExistsSKU(sku string, excludeID uint) (bool, error)
 
 	Create(product *models.Product) (*models.Product, error)
+	Update(id uint, updates map[string]interface{}) error
 	Delete(id uint) error
+	ReplaceUnits(productID uint, units []models.ProductUnit) error
+	ReplaceAttributes(productID uint, attributes []models.ProductAttribute) error
 
 	GetCountWithComparison() (int64, float64, error)
 }
@@ -28,8 +34,8 @@ func NewProductRepository(db *gorm.DB) ProductRepository {
 	}
 }
 
-func (r *productRepository) FindAll(search_filter map[string]interface{}) ([]models.Product, error) {
-	var products []models.Product
+// 검색 조건을 적용한 쿼리
+func (r *productRepository) searchQuery(search_filter map[string]interface{}) *gorm.DB {
 	query := r.db
 
 	for key, value := range search_filter {
@@ -38,28 +44,93 @@ func (r *productRepository) FindAll(search_filter map[string]interface{}) ([]mod
 			query = query.Where("name LIKE ?", "%"+value.(string)+"%")
 		case "sku":
 			query = query.Where("sku LIKE ?", "%"+value.(string)+"%")
+		case "category_ids":
+			query = query.Where("category_id IN ?", value)
+		case "parent_id":
+			query = query.Where("parent_id = ?", value)
+		case "attributes":
+			// 속성 이름=값 조건을 모두 만족하는 제품만 조회
+			for name, attributeValue := range value.(map[string]string) {
+				query = query.Where(
+					"EXISTS (SELECT 1 FROM product_attributes WHERE product_attributes.product_id = products.id AND product_attributes.name = ? AND product_attributes.value = ? AND product_attributes.deleted_at IS NULL)",
+					name, attributeValue,
+				)
+			}
 		}
 	}
 
-	if err := query.Preload("Inventories").Find(&products).Error; err != nil {
+	return query
+}
+
+// 목록 조회 시 함께 조회하는 연관관계
+func preloadProductList(query *gorm.DB) *gorm.DB {
+	return query.Preload("Units").Preload("Attributes").Preload("Inventories").Preload("Variants").Preload("Variants.Inventories")
+}
+
+func (r *productRepository) FindAll(search_filter map[string]interface{}) ([]models.Product, error) {
+	var products []models.Product
+
+	if err := preloadProductList(r.searchQuery(search_filter)).Find(&products).Error; err != nil {
 		return nil, err
 	}
 
 	return products, nil
 }
 
+// 검색 조건에 맞는 제품을 페이지 단위로 조회
+func (r *productRepository) FindPage(search_filter map[string]interface{}, page models.PageQuery) ([]models.Product, *models.Pagination, error) {
+	var products []models.Product
+
+	query, pagination, err := paginate(r.searchQuery(search_filter), &models.Product{}, "products", page)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := preloadProductList(query).Find(&products).Error; err != nil {
+		return nil, nil, err
+	}
+
+	products, err = trimPage(products, pagination, (*models.Product).SortValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return products, pagination, nil
+}
+
 func (r *productRepository) FindByID(id uint) (*models.Product, error) {
 	var product models.Product
 
 	// if err := r.db.Preload("Inventories").Preload("Inventories.Product").First(&warehouse, id).Error; err != nil {
 	// if err := r.db.Preload("Inventories").First(&product, id).Error; err != nil {
-	if err := r.db.Preload("Inventories").Preload("Inventories.Warehouse").Where("id = ?", id).First(&product).Error; err != nil {
+	if err := r.db.Preload("Units").Preload("Category").Preload("Attributes").Preload("Inventories").Preload("Inventories.Warehouse").
+		Preload("Variants").Preload("Variants.Attributes").Preload("Variants.Inventories").Where("id = ?", id).First(&product).Error; err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+func (r *productRepository) FindBySKU(sku string) (*models.Product, error) {
+	var product models.Product
+
+	if err := r.db.Preload("Units").Where("sku = ?", sku).First(&product).Error; err != nil {
 		return nil, err
 	}
 
 	return &product, nil
 }
 
+// 다른 제품이 SKU 를 사용 중인지 확인 ( 삭제된 제품도 유일 인덱스를 차지하므로 포함 )
+func (r *productRepository) ExistsSKU(sku string, excludeID uint) (bool, error) {
+	var count int64
+	if err := r.db.Unscoped().Model(&models.Product{}).Where("sku = ? AND id <> ?", sku, excludeID).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
 func (r *productRepository) Create(product *models.Product) (*models.Product, error) {
 	if err := r.db.Create(product).Error; err != nil {
 		return nil, err
@@ -68,6 +139,11 @@ func (r *productRepository) Create(product *models.Product) (*models.Product, er
 	return product, nil
 }
 
+// 지정한 컬럼만 변경 ( 빈 값/nil 도 반영되도록 map 사용 )
+func (r *productRepository) Update(id uint, updates map[string]interface{}) error {
+	return r.db.Model(&models.Product{}).Where("id = ?", id).Updates(updates).Error
+}
+
 func (r *productRepository) Delete(id uint) error {
 	tx := r.db.Begin()
 	if tx.Error != nil {
@@ -104,6 +180,44 @@ func (r *productRepository) Delete(id uint) error {
 	return nil
 }
 
+// 제품의 환산 단위를 전체 교체 ( 이름 유일 인덱스 때문에 기존 단위는 완전 삭제 )
+func (r *productRepository) ReplaceUnits(productID uint, units []models.ProductUnit) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("product_id = ?", productID).Delete(&models.ProductUnit{}).Error; err != nil {
+			return err
+		}
+
+		if len(units) == 0 {
+			return nil
+		}
+
+		for i := range units {
+			units[i].ProductID = productID
+		}
+
+		return tx.Create(&units).Error
+	})
+}
+
+// 제품의 사용자 정의 속성을 전체 교체 ( 이름 유일 인덱스 때문에 기존 속성은 완전 삭제 )
+func (r *productRepository) ReplaceAttributes(productID uint, attributes []models.ProductAttribute) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("product_id = ?", productID).Delete(&models.ProductAttribute{}).Error; err != nil {
+			return err
+		}
+
+		if len(attributes) == 0 {
+			return nil
+		}
+
+		for i := range attributes {
+			attributes[i].ProductID = productID
+		}
+
+		return tx.Create(&attributes).Error
+	})
+}
+
 func (r *productRepository) GetCountWithComparison() (int64, float64, error) {
 	var totalCount int64
 	if err := r.db.Model(&models.Product{}).Count(&totalCount).Error; err != nil {
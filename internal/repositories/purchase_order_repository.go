@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type PurchaseOrderRepository interface {
+	FindAll(search_filter map[string]interface{}) ([]models.PurchaseOrder, error)
+	FindByID(id uint) (*models.PurchaseOrder, error)
+	Create(purchaseOrder *models.PurchaseOrder) (*models.PurchaseOrder, error)
+	Update(purchaseOrder *models.PurchaseOrder) error
+	UpdateStatus(id uint, status string) error
+	AddReceivedQuantity(lineID uint, quantity int) error
+	Delete(id uint) error
+
+	WithTx(tx *gorm.DB) PurchaseOrderRepository
+}
+
+type purchaseOrderRepository struct {
+	db *gorm.DB
+}
+
+func NewPurchaseOrderRepository(db *gorm.DB) PurchaseOrderRepository {
+	return &purchaseOrderRepository{
+		db: db,
+	}
+}
+
+// 모든 발주 조회
+func (r *purchaseOrderRepository) FindAll(search_filter map[string]interface{}) ([]models.PurchaseOrder, error) {
+	var purchaseOrders []models.PurchaseOrder
+	query := r.db
+
+	for key, value := range search_filter {
+		switch key {
+		case "supplier_id":
+			query = query.Where("supplier_id = ?", value)
+		case "warehouse_id":
+			query = query.Where("warehouse_id = ?", value)
+		case "status":
+			query = query.Where("status = ?", value)
+		}
+	}
+
+	if err := query.Preload("Supplier").Preload("Lines").Find(&purchaseOrders).Error; err != nil {
+		return nil, err
+	}
+
+	return purchaseOrders, nil
+}
+
+// 발주 조회
+func (r *purchaseOrderRepository) FindByID(id uint) (*models.PurchaseOrder, error) {
+	var purchaseOrder models.PurchaseOrder
+
+	if err := r.db.Preload("Supplier").Preload("Warehouse").Preload("Lines").Preload("Lines.Product").First(&purchaseOrder, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &purchaseOrder, nil
+}
+
+// 발주 및 항목 생성
+func (r *purchaseOrderRepository) Create(purchaseOrder *models.PurchaseOrder) (*models.PurchaseOrder, error) {
+	if err := r.db.Create(purchaseOrder).Error; err != nil {
+		return nil, err
+	}
+
+	return purchaseOrder, nil
+}
+
+// 작성 중인 발주의 내용과 항목 전체 교체
+func (r *purchaseOrderRepository) Update(purchaseOrder *models.PurchaseOrder) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.PurchaseOrder{}).Where("id = ?", purchaseOrder.ID).Updates(map[string]interface{}{
+			"supplier_id":  purchaseOrder.SupplierID,
+			"warehouse_id": purchaseOrder.WarehouseID,
+			"note":         purchaseOrder.Note,
+			"expected_at":  purchaseOrder.ExpectedAt,
+		}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("purchase_order_id = ?", purchaseOrder.ID).Delete(&models.PurchaseOrderLine{}).Error; err != nil {
+			return err
+		}
+
+		for i := range purchaseOrder.Lines {
+			purchaseOrder.Lines[i].PurchaseOrderID = purchaseOrder.ID
+		}
+
+		return tx.Create(&purchaseOrder.Lines).Error
+	})
+}
+
+// 발주 상태 변경 ( 발주 / 입고 완료 시간 기록 )
+func (r *purchaseOrderRepository) UpdateStatus(id uint, status string) error {
+	updates := map[string]interface{}{
+		"status": status,
+	}
+
+	switch status {
+	case models.PURCHASE_STATUS_ORDERED:
+		updates["ordered_at"] = models.GetNowTime()
+	case models.PURCHASE_STATUS_RECEIVED:
+		updates["received_at"] = models.GetNowTime()
+	}
+
+	return r.db.Model(&models.PurchaseOrder{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// 발주 항목의 입고 수량 증가
+func (r *purchaseOrderRepository) AddReceivedQuantity(lineID uint, quantity int) error {
+	return r.db.Model(&models.PurchaseOrderLine{}).
+		Where("id = ?", lineID).
+		Update("received_quantity", gorm.Expr("received_quantity + ?", quantity)).Error
+}
+
+func (r *purchaseOrderRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("purchase_order_id = ?", id).Delete(&models.PurchaseOrderLine{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&models.PurchaseOrder{}, id).Error
+	})
+}
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *purchaseOrderRepository) WithTx(tx *gorm.DB) PurchaseOrderRepository {
+	return &purchaseOrderRepository{
+		db: tx,
+	}
+}
@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"time"
+)
+
+type ReservationRepository interface {
+	FindAll(search_filter map[string]interface{}) ([]models.Reservation, error)
+	FindByID(id uint) (*models.Reservation, error)
+	FindExpired(now time.Time) ([]models.Reservation, error)
+	FindActiveByOwnerForUpdate(ownerReference string) ([]models.Reservation, error)
+	Create(reservation *models.Reservation) (*models.Reservation, error)
+	Release(id uint, status string) (bool, error)
+
+	WithTx(tx *gorm.DB) ReservationRepository
+	Transaction(fn func(tx *gorm.DB) error) error
+}
+
+type reservationRepository struct {
+	db *gorm.DB
+}
+
+func NewReservationRepository(db *gorm.DB) ReservationRepository {
+	return &reservationRepository{
+		db: db,
+	}
+}
+
+// 모든 예약 조회
+func (r *reservationRepository) FindAll(search_filter map[string]interface{}) ([]models.Reservation, error) {
+	var reservations []models.Reservation
+	query := r.db
+
+	for key, value := range search_filter {
+		switch key {
+		case "inventory_id":
+			query = query.Where("inventory_id = ?", value)
+		case "status":
+			query = query.Where("status = ?", value)
+		case "owner_reference":
+			query = query.Where("owner_reference = ?", value)
+		}
+	}
+
+	if err := query.Find(&reservations).Error; err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// 예약 조회
+func (r *reservationRepository) FindByID(id uint) (*models.Reservation, error) {
+	var reservation models.Reservation
+
+	if err := r.db.Preload("Inventory").First(&reservation, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+// 만료 시간이 지난 활성 예약 조회
+func (r *reservationRepository) FindExpired(now time.Time) ([]models.Reservation, error) {
+	var reservations []models.Reservation
+
+	if err := r.db.Where("status = ? AND expires_at <= ?", models.RESERVATION_STATUS_ACTIVE, now).Find(&reservations).Error; err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// 예약 주체의 활성 예약을 잠금 후 조회 ( 트랜잭션 안에서 사용 )
+func (r *reservationRepository) FindActiveByOwnerForUpdate(ownerReference string) ([]models.Reservation, error) {
+	var reservations []models.Reservation
+
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("owner_reference = ? AND status = ?", ownerReference, models.RESERVATION_STATUS_ACTIVE).
+		Order("id ASC").
+		Find(&reservations).Error; err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// 예약 생성
+func (r *reservationRepository) Create(reservation *models.Reservation) (*models.Reservation, error) {
+	if err := r.db.Create(reservation).Error; err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+// 활성 예약의 상태를 해제 상태로 변경 ( 이미 해제된 경우 false 반환 )
+func (r *reservationRepository) Release(id uint, status string) (bool, error) {
+	result := r.db.Model(&models.Reservation{}).
+		Where("id = ? AND status = ?", id, models.RESERVATION_STATUS_ACTIVE).
+		Update("status", status)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *reservationRepository) WithTx(tx *gorm.DB) ReservationRepository {
+	return &reservationRepository{
+		db: tx,
+	}
+}
+
+// 여러 작업을 하나의 DB 트랜잭션으로 묶어서 실행
+func (r *reservationRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
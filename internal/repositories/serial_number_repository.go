@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SerialNumberRepository interface {
+	FindBySerial(serial string) (*models.SerialNumber, error)
+	FindBySerialsForUpdate(serials []string) ([]models.SerialNumber, error)
+	Save(serialNumber *models.SerialNumber) error
+	AppendTransaction(transaction *models.Transaction, serialNumbers []models.SerialNumber) error
+
+	WithTx(tx *gorm.DB) SerialNumberRepository
+}
+
+type serialNumberRepository struct {
+	db *gorm.DB
+}
+
+func NewSerialNumberRepository(db *gorm.DB) SerialNumberRepository {
+	return &serialNumberRepository{
+		db: db,
+	}
+}
+
+// 일련번호와 전체 입고/출고 이력 조회 ( 시간 순 )
+func (r *serialNumberRepository) FindBySerial(serial string) (*models.SerialNumber, error) {
+	var serialNumber models.SerialNumber
+
+	if err := r.db.Preload("Product").
+		Preload("Inventory").
+		Preload("Inventory.Warehouse").
+		Preload("Transactions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("timestamp ASC, id ASC")
+		}).
+		Preload("Transactions.Inventory").
+		Preload("Transactions.Inventory.Warehouse").
+		Where("serial = ?", serial).
+		First(&serialNumber).Error; err != nil {
+		return nil, err
+	}
+
+	return &serialNumber, nil
+}
+
+// 일련번호 목록을 잠금 후 조회
+func (r *serialNumberRepository) FindBySerialsForUpdate(serials []string) ([]models.SerialNumber, error) {
+	var serialNumbers []models.SerialNumber
+
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("serial IN ?", serials).Find(&serialNumbers).Error; err != nil {
+		return nil, err
+	}
+
+	return serialNumbers, nil
+}
+
+// 일련번호 생성 또는 수정
+func (r *serialNumberRepository) Save(serialNumber *models.SerialNumber) error {
+	return r.db.Save(serialNumber).Error
+}
+
+// 재고내역-일련번호 이력 연결
+func (r *serialNumberRepository) AppendTransaction(transaction *models.Transaction, serialNumbers []models.SerialNumber) error {
+	return r.db.Model(transaction).Omit("SerialNumbers.*").Association("SerialNumbers").Append(serialNumbers)
+}
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *serialNumberRepository) WithTx(tx *gorm.DB) SerialNumberRepository {
+	return &serialNumberRepository{
+		db: tx,
+	}
+}
@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type StockAlertRepository interface {
+	FindAll(search_filter map[string]interface{}) ([]models.StockAlert, error)
+	FindByID(id uint) (*models.StockAlert, error)
+	Create(stockAlert *models.StockAlert) (*models.StockAlert, error)
+	Acknowledge(id uint) error
+
+	WithTx(tx *gorm.DB) StockAlertRepository
+}
+
+type stockAlertRepository struct {
+	db *gorm.DB
+}
+
+func NewStockAlertRepository(db *gorm.DB) StockAlertRepository {
+	return &stockAlertRepository{
+		db: db,
+	}
+}
+
+func (r *stockAlertRepository) FindAll(search_filter map[string]interface{}) ([]models.StockAlert, error) {
+	var stockAlerts []models.StockAlert
+	query := r.db
+
+	for key, value := range search_filter {
+		switch key {
+		case "warehouse_id":
+			query = query.Where("warehouse_id = ?", value)
+		case "inventory_id":
+			query = query.Where("inventory_id = ?", value)
+		case "level":
+			query = query.Where("level = ?", value)
+		case "acknowledged":
+			query = query.Where("acknowledged = ?", value)
+		}
+	}
+
+	if err := query.Preload("Inventory").Preload("Inventory.Product").Order("created_at DESC").Find(&stockAlerts).Error; err != nil {
+		return nil, err
+	}
+
+	return stockAlerts, nil
+}
+
+func (r *stockAlertRepository) FindByID(id uint) (*models.StockAlert, error) {
+	var stockAlert models.StockAlert
+
+	if err := r.db.Preload("Inventory").Preload("Inventory.Product").First(&stockAlert, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &stockAlert, nil
+}
+
+func (r *stockAlertRepository) Create(stockAlert *models.StockAlert) (*models.StockAlert, error) {
+	if err := r.db.Create(stockAlert).Error; err != nil {
+		return nil, err
+	}
+
+	return stockAlert, nil
+}
+
+// 알림 확인 처리
+func (r *stockAlertRepository) Acknowledge(id uint) error {
+	return r.db.Model(&models.StockAlert{}).Where("id = ?", id).Update("acknowledged", true).Error
+}
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *stockAlertRepository) WithTx(tx *gorm.DB) StockAlertRepository {
+	return &stockAlertRepository{
+		db: tx,
+	}
+}
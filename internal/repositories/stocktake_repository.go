@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StocktakeRepository interface {
+	FindAll(search_filter map[string]interface{}) ([]models.Stocktake, error)
+	FindByID(id uint) (*models.Stocktake, error)
+	FindByIDForUpdate(id uint) (*models.Stocktake, error)
+	Create(stocktake *models.Stocktake) (*models.Stocktake, error)
+	UpdateCountedQuantity(stocktakeID, lineID uint, countedQuantity int) (bool, error)
+	UpdateStatus(id uint, from, to string) (bool, error)
+	Approve(id uint, userID uint) (bool, error)
+
+	WithTx(tx *gorm.DB) StocktakeRepository
+}
+
+type stocktakeRepository struct {
+	db *gorm.DB
+}
+
+func NewStocktakeRepository(db *gorm.DB) StocktakeRepository {
+	return &stocktakeRepository{
+		db: db,
+	}
+}
+
+// 모든 실사 조회
+func (r *stocktakeRepository) FindAll(search_filter map[string]interface{}) ([]models.Stocktake, error) {
+	var stocktakes []models.Stocktake
+	query := r.db
+
+	for key, value := range search_filter {
+		switch key {
+		case "warehouse_id":
+			query = query.Where("warehouse_id = ?", value)
+		case "status":
+			query = query.Where("status = ?", value)
+		}
+	}
+
+	if err := query.Preload("Warehouse").Find(&stocktakes).Error; err != nil {
+		return nil, err
+	}
+
+	return stocktakes, nil
+}
+
+// 실사 및 항목 조회
+func (r *stocktakeRepository) FindByID(id uint) (*models.Stocktake, error) {
+	var stocktake models.Stocktake
+
+	if err := r.db.Preload("Warehouse").
+		Preload("Lines").
+		Preload("Lines.Inventory").
+		Preload("Lines.Inventory.Product").
+		Preload("Lines.BinLocation").
+		First(&stocktake, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &stocktake, nil
+}
+
+// 실사 행에 잠금을 걸고 항목과 함께 조회 ( 트랜잭션 안에서 사용 )
+func (r *stocktakeRepository) FindByIDForUpdate(id uint) (*models.Stocktake, error) {
+	var stocktake models.Stocktake
+
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Lines").First(&stocktake, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &stocktake, nil
+}
+
+// 실사 및 항목 생성
+func (r *stocktakeRepository) Create(stocktake *models.Stocktake) (*models.Stocktake, error) {
+	if err := r.db.Create(stocktake).Error; err != nil {
+		return nil, err
+	}
+
+	return stocktake, nil
+}
+
+// 진행 중인 실사 항목의 실사 수량 입력 ( 다시 입력하면 덮어씀, 실사가 승인/취소된 경우 false 반환 )
+func (r *stocktakeRepository) UpdateCountedQuantity(stocktakeID, lineID uint, countedQuantity int) (bool, error) {
+	openStocktake := r.db.Model(&models.Stocktake{}).
+		Select("id").
+		Where("id = ? AND status = ?", stocktakeID, models.STOCKTAKE_STATUS_OPEN)
+
+	result := r.db.Model(&models.StocktakeLine{}).
+		Where("id = ? AND stocktake_id IN (?)", lineID, openStocktake).
+		Update("counted_quantity", countedQuantity)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// 실사 상태 변경 ( 이전 상태 그대로인 경우에만 변경하고 다른 요청이 먼저 바꾼 경우 false 반환 )
+func (r *stocktakeRepository) UpdateStatus(id uint, from, to string) (bool, error) {
+	result := r.db.Model(&models.Stocktake{}).
+		Where("id = ? AND status = ?", id, from).
+		Update("status", to)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// 진행 중인 실사 승인 ( 승인자와 승인 시간 기록, 이미 승인/취소된 경우 false 반환 )
+func (r *stocktakeRepository) Approve(id uint, userID uint) (bool, error) {
+	result := r.db.Model(&models.Stocktake{}).
+		Where("id = ? AND status = ?", id, models.STOCKTAKE_STATUS_OPEN).
+		Updates(map[string]interface{}{
+			"status":      models.STOCKTAKE_STATUS_APPROVED,
+			"approved_by": userID,
+			"approved_at": models.GetNowTime(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *stocktakeRepository) WithTx(tx *gorm.DB) StocktakeRepository {
+	return &stocktakeRepository{
+		db: tx,
+	}
+}
@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type SupplierRepository interface {
+	FindAll(search_filter map[string]interface{}) ([]models.Supplier, error)
+	FindByID(id uint) (*models.Supplier, error)
+	FindByName(name string) (*models.Supplier, error)
+	Create(supplier *models.Supplier) (*models.Supplier, error)
+	Update(supplier *models.Supplier) (*models.Supplier, error)
+	Delete(id uint) error
+}
+
+type supplierRepository struct {
+	db *gorm.DB
+}
+
+func NewSupplierRepository(db *gorm.DB) SupplierRepository {
+	return &supplierRepository{
+		db: db,
+	}
+}
+
+func (r *supplierRepository) FindAll(search_filter map[string]interface{}) ([]models.Supplier, error) {
+	var suppliers []models.Supplier
+	query := r.db
+
+	for key, value := range search_filter {
+		switch key {
+		case "name":
+			query = query.Where("name LIKE ?", "%"+value.(string)+"%")
+		case "email":
+			query = query.Where("email = ?", value)
+		}
+	}
+
+	if err := query.Find(&suppliers).Error; err != nil {
+		return nil, err
+	}
+
+	return suppliers, nil
+}
+
+func (r *supplierRepository) FindByID(id uint) (*models.Supplier, error) {
+	var supplier models.Supplier
+
+	if err := r.db.First(&supplier, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &supplier, nil
+}
+
+func (r *supplierRepository) FindByName(name string) (*models.Supplier, error) {
+	var supplier models.Supplier
+
+	if err := r.db.Where("name = ?", name).First(&supplier).Error; err != nil {
+		return nil, err
+	}
+
+	return &supplier, nil
+}
+
+func (r *supplierRepository) Create(supplier *models.Supplier) (*models.Supplier, error) {
+	if err := r.db.Create(supplier).Error; err != nil {
+		return nil, err
+	}
+
+	return supplier, nil
+}
+
+func (r *supplierRepository) Update(supplier *models.Supplier) (*models.Supplier, error) {
+	if err := r.db.Save(supplier).Error; err != nil {
+		return nil, err
+	}
+
+	return supplier, nil
+}
+
+func (r *supplierRepository) Delete(id uint) error {
+	return r.db.Delete(&models.Supplier{}, id).Error
+}
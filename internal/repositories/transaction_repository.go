@@ -4,14 +4,26 @@ import (
 	"github.com/jhphon0730/StockFlow/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"time"
 )
 
 type TransactionRepository interface {
 	FindAll(search_filter map[string]interface{}) ([]models.Transaction, error)
+	FindPage(search_filter map[string]interface{}, page models.PageQuery) ([]models.Transaction, *models.Pagination, error)
 	FindByID(id uint) (*models.Transaction, error)
 	Create(transaction *models.Transaction) (*models.Transaction, error)
-	Delete(id uint) error
+	FindByIDForUpdate(id uint) (*models.Transaction, error)
+	MarkReversed(id uint, reversedAt time.Time) error
 	FindRecentTransactions(limit int) ([]models.Transaction, error)
+	FindByInventoryIDsUntil(inventoryIDs []uint, until time.Time) ([]models.Transaction, error)
+	SumQuantityByInventoryIDsAsOf(inventoryIDs []uint, asOf time.Time) (map[uint]int, error)
+	FindByReferenceWithDetails(reference string) ([]models.Transaction, error)
+	UpdateCost(id uint, unitCost, costOfGoods float64) error
+
+	WithTx(tx *gorm.DB) TransactionRepository
+	Transaction(fn func(tx *gorm.DB) error) error
 }
 
 type transactionRepository struct {
@@ -27,6 +39,37 @@ func NewTransactionRepository(db *gorm.DB) TransactionRepository {
 // 모든 재고내역 조회 
 func (r *transactionRepository) FindAll(search_filter map[string]interface{}) ([]models.Transaction, error) {
 	var transactions []models.Transaction
+
+	if err := r.searchQuery(search_filter).Find(&transactions).Error; err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// 검색 조건에 맞는 재고내역을 페이지 단위로 조회
+func (r *transactionRepository) FindPage(search_filter map[string]interface{}, page models.PageQuery) ([]models.Transaction, *models.Pagination, error) {
+	var transactions []models.Transaction
+
+	query, pagination, err := paginate(r.searchQuery(search_filter), &models.Transaction{}, "transactions", page)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := query.Find(&transactions).Error; err != nil {
+		return nil, nil, err
+	}
+
+	transactions, err = trimPage(transactions, pagination, (*models.Transaction).SortValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return transactions, pagination, nil
+}
+
+// 검색 조건을 적용한 쿼리
+func (r *transactionRepository) searchQuery(search_filter map[string]interface{}) *gorm.DB {
 	query := r.db
 
 	for key, value := range search_filter {
@@ -35,14 +78,12 @@ func (r *transactionRepository) FindAll(search_filter map[string]interface{}) ([
 			query = query.Where("inventory_id = ?", value)
 		case "type":
 			query = query.Where("type = ?", value)
+		case "reference":
+			query = query.Where("reference = ?", value)
 		}
 	}
 
-	if err := query.Find(&transactions).Error; err != nil {
-		return nil, err
-	}
-
-	return transactions, nil
+	return query
 }
 
 // 재고내역 조회
@@ -65,21 +106,37 @@ func (r *transactionRepository) Create(transaction *models.Transaction) (*models
 	return transaction, nil
 }
 
-// 재고내역 삭제
-func (r *transactionRepository) Delete(id uint) error {
-	tx := r.db.Begin()
-	if tx.Error != nil {
-		return tx.Error
-	}
+// 재고내역 행에 잠금을 걸고 로트/일련번호와 함께 조회 ( 트랜잭션 안에서 사용 )
+func (r *transactionRepository) FindByIDForUpdate(id uint) (*models.Transaction, error) {
+	var transaction models.Transaction
 
-	if err := tx.Delete(&models.Transaction{}, id).Error; err != nil {
-		tx.Rollback()
-		return err
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Lots").
+		Preload("Lots.Lot").
+		Preload("SerialNumbers").
+		First(&transaction, id).Error; err != nil {
+		return nil, err
 	}
 
-	return tx.Commit().Error
+	return &transaction, nil
+}
+
+// 재고내역을 취소 상태로 변경
+func (r *transactionRepository) MarkReversed(id uint, reversedAt time.Time) error {
+	return r.db.Model(&models.Transaction{}).
+		Where("id = ?", id).
+		Update("reversed_at", reversedAt).Error
 }
 
+// 재고내역의 단가와 매출원가 변경 ( 원가층 반영 후 호출 )
+func (r *transactionRepository) UpdateCost(id uint, unitCost, costOfGoods float64) error {
+	return r.db.Model(&models.Transaction{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"unit_cost":     unitCost,
+			"cost_of_goods": costOfGoods,
+		}).Error
+}
 
 func (r *transactionRepository) FindRecentTransactions(limit int) ([]models.Transaction, error) {
 	var transactions []models.Transaction
@@ -91,3 +148,78 @@ func (r *transactionRepository) FindRecentTransactions(limit int) ([]models.Tran
 	return transactions, nil
 }
 
+// 재고들의 지정 시각까지의 재고내역을 반영된 순서로 조회
+// - Timestamp 는 재고 행 잠금 전에 기록되어 동시 요청에서는 반영 순서와 다를 수 있으므로 ID 순서로 정렬하고 시각은 기준 시점으로만 사용
+func (r *transactionRepository) FindByInventoryIDsUntil(inventoryIDs []uint, until time.Time) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+
+	if len(inventoryIDs) == 0 {
+		return transactions, nil
+	}
+
+	if err := r.db.Where("inventory_id IN ? AND timestamp <= ?", inventoryIDs, until).
+		Order("id ASC").
+		Find(&transactions).Error; err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// 재고들의 지정 시각까지의 재고 변경량 합계 ( 재고내역이 없는 재고는 결과에 없음 )
+// - 지정 시각 이후 삭제된 재고내역도 그 시점에는 반영되어 있었으므로 포함
+// - 조정(ADJUST)은 반영 전 수량과의 차이를 변경량으로 사용
+func (r *transactionRepository) SumQuantityByInventoryIDsAsOf(inventoryIDs []uint, asOf time.Time) (map[uint]int, error) {
+	quantities := make(map[uint]int)
+
+	if len(inventoryIDs) == 0 {
+		return quantities, nil
+	}
+
+	var rows []struct {
+		InventoryID uint
+		Quantity    int
+	}
+	if err := r.db.Unscoped().Model(&models.Transaction{}).
+		Select("inventory_id, SUM(CASE type WHEN 'IN' THEN quantity WHEN 'OUT' THEN -quantity WHEN 'ADJUST' THEN quantity - quantity_before ELSE 0 END) AS quantity").
+		Where("inventory_id IN ? AND timestamp <= ?", inventoryIDs, asOf).
+		Where("deleted_at IS NULL OR deleted_at > ?", asOf).
+		Group("inventory_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		quantities[row.InventoryID] = row.Quantity
+	}
+
+	return quantities, nil
+}
+
+// 연관 문서 참조 값의 재고내역을 로트/일련번호와 함께 생성 순서로 조회
+func (r *transactionRepository) FindByReferenceWithDetails(reference string) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+
+	if err := r.db.Where("reference = ?", reference).
+		Preload("Lots").
+		Preload("Lots.Lot").
+		Preload("SerialNumbers").
+		Order("id ASC").
+		Find(&transactions).Error; err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *transactionRepository) WithTx(tx *gorm.DB) TransactionRepository {
+	return &transactionRepository{
+		db: tx,
+	}
+}
+
+// 여러 작업을 하나의 DB 트랜잭션으로 묶어서 실행
+func (r *transactionRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
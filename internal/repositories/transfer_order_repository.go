@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TransferOrderRepository interface {
+	FindAll(search_filter map[string]interface{}) ([]models.TransferOrder, error)
+	FindByID(id uint) (*models.TransferOrder, error)
+	FindByIDForUpdate(id uint) (*models.TransferOrder, error)
+	Create(transferOrder *models.TransferOrder) (*models.TransferOrder, error)
+	UpdateStatus(id uint, from, to string) (bool, error)
+	UpdateItemUnitCost(itemID uint, unitCost float64) error
+	AddReceivedQuantity(itemID uint, quantity int) (bool, error)
+	GetInTransitQuantities() ([]models.InTransitQuantity, error)
+
+	WithTx(tx *gorm.DB) TransferOrderRepository
+}
+
+type transferOrderRepository struct {
+	db *gorm.DB
+}
+
+func NewTransferOrderRepository(db *gorm.DB) TransferOrderRepository {
+	return &transferOrderRepository{
+		db: db,
+	}
+}
+
+// 모든 이동 지시 조회
+func (r *transferOrderRepository) FindAll(search_filter map[string]interface{}) ([]models.TransferOrder, error) {
+	var transferOrders []models.TransferOrder
+	query := r.db
+
+	for key, value := range search_filter {
+		switch key {
+		case "source_warehouse_id":
+			query = query.Where("source_warehouse_id = ?", value)
+		case "destination_warehouse_id":
+			query = query.Where("destination_warehouse_id = ?", value)
+		case "status":
+			query = query.Where("status = ?", value)
+		}
+	}
+
+	if err := query.Preload("Items").Find(&transferOrders).Error; err != nil {
+		return nil, err
+	}
+
+	return transferOrders, nil
+}
+
+// 이동 지시 조회
+func (r *transferOrderRepository) FindByID(id uint) (*models.TransferOrder, error) {
+	var transferOrder models.TransferOrder
+
+	if err := r.db.Preload("SourceWarehouse").Preload("DestinationWarehouse").Preload("Items").Preload("Items.Product").First(&transferOrder, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &transferOrder, nil
+}
+
+// 이동 지시와 항목 행에 잠금을 걸고 조회 ( 트랜잭션 안에서 사용 )
+func (r *transferOrderRepository) FindByIDForUpdate(id uint) (*models.TransferOrder, error) {
+	var transferOrder models.TransferOrder
+
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Clauses(clause.Locking{Strength: "UPDATE"}).Order("id ASC")
+		}).
+		First(&transferOrder, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &transferOrder, nil
+}
+
+// 이동 지시 및 항목 생성
+func (r *transferOrderRepository) Create(transferOrder *models.TransferOrder) (*models.TransferOrder, error) {
+	if err := r.db.Create(transferOrder).Error; err != nil {
+		return nil, err
+	}
+
+	return transferOrder, nil
+}
+
+// 이동 지시 상태를 from 에서 to 로 변경 ( 출고 / 입고 완료 시간 기록, 그 사이 상태가 바뀐 경우 false 반환 )
+func (r *transferOrderRepository) UpdateStatus(id uint, from, to string) (bool, error) {
+	updates := map[string]interface{}{
+		"status": to,
+	}
+
+	switch to {
+	case models.TRANSFER_STATUS_IN_TRANSIT:
+		updates["dispatched_at"] = models.GetNowTime()
+	case models.TRANSFER_STATUS_RECEIVED:
+		updates["received_at"] = models.GetNowTime()
+	}
+
+	result := r.db.Model(&models.TransferOrder{}).Where("id = ? AND status = ?", id, from).Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// 이동 지시 항목의 입고 수량 증가 ( 입고 수량이 이동 수량을 넘게 되는 경우 false 반환 )
+func (r *transferOrderRepository) AddReceivedQuantity(itemID uint, quantity int) (bool, error) {
+	result := r.db.Model(&models.TransferOrderItem{}).
+		Where("id = ? AND received_quantity + ? <= quantity", itemID, quantity).
+		Update("received_quantity", gorm.Expr("received_quantity + ?", quantity))
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// 출고 시 계산된 항목 단가 저장
+func (r *transferOrderRepository) UpdateItemUnitCost(itemID uint, unitCost float64) error {
+	return r.db.Model(&models.TransferOrderItem{}).
+		Where("id = ?", itemID).
+		Update("unit_cost", unitCost).Error
+}
+
+// 출고되었지만 아직 입고되지 않은 제품별 수량
+func (r *transferOrderRepository) GetInTransitQuantities() ([]models.InTransitQuantity, error) {
+	var quantities []models.InTransitQuantity
+
+	if err := r.db.Model(&models.TransferOrderItem{}).
+		Select("transfer_order_items.product_id, SUM(transfer_order_items.quantity - transfer_order_items.received_quantity) AS quantity").
+		Joins("JOIN transfer_orders ON transfer_orders.id = transfer_order_items.transfer_order_id AND transfer_orders.deleted_at IS NULL").
+		Where("transfer_orders.status IN ?", []string{models.TRANSFER_STATUS_IN_TRANSIT, models.TRANSFER_STATUS_PARTIALLY_RECEIVED}).
+		Group("transfer_order_items.product_id").
+		Scan(&quantities).Error; err != nil {
+		return nil, err
+	}
+
+	return quantities, nil
+}
+
+// 외부 DB 트랜잭션을 공유하는 Repository 반환
+func (r *transferOrderRepository) WithTx(tx *gorm.DB) TransferOrderRepository {
+	return &transferOrderRepository{
+		db: tx,
+	}
+}
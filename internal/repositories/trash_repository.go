@@ -0,0 +1,311 @@
+package repositories
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"gorm.io/gorm"
+
+	"time"
+)
+
+// 삭제(soft delete)된 레코드 조회, 복원, 영구 삭제
+type TrashRepository interface {
+	FindDeleted(recordType string) (*models.Trash, error)
+	FindDeletedWarehouse(id uint) (*models.Warehouse, error)
+	FindDeletedProduct(id uint) (*models.Product, error)
+	FindDeletedInventory(id uint) (*models.Inventory, error)
+	FindDeletedTransaction(id uint) (*models.Transaction, error)
+
+	RestoreWarehouse(warehouse *models.Warehouse) (*models.TrashCount, error)
+	RestoreProduct(product *models.Product) (*models.TrashCount, error)
+	RestoreInventory(inventory *models.Inventory) (*models.TrashCount, error)
+
+	Purge(before time.Time) (*models.TrashPurgeResult, error)
+}
+
+type trashRepository struct {
+	db *gorm.DB
+}
+
+func NewTrashRepository(db *gorm.DB) TrashRepository {
+	return &trashRepository{
+		db: db,
+	}
+}
+
+// 삭제된 레코드만 조회하는 쿼리
+func (r *trashRepository) deleted() *gorm.DB {
+	return r.db.Unscoped().Where("deleted_at IS NOT NULL")
+}
+
+// 유형별 삭제된 레코드 ( 빈 값이면 전체 )
+func (r *trashRepository) FindDeleted(recordType string) (*models.Trash, error) {
+	trash := &models.Trash{}
+
+	if recordType == "" || recordType == models.TRASH_TYPE_WAREHOUSES {
+		if err := r.deleted().Order("deleted_at DESC").Find(&trash.Warehouses).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if recordType == "" || recordType == models.TRASH_TYPE_PRODUCTS {
+		if err := r.deleted().Order("deleted_at DESC").Find(&trash.Products).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if recordType == "" || recordType == models.TRASH_TYPE_INVENTORIES {
+		if err := r.deleted().Order("deleted_at DESC").Find(&trash.Inventories).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if recordType == "" || recordType == models.TRASH_TYPE_TRANSACTIONS {
+		if err := r.deleted().Order("deleted_at DESC").Find(&trash.Transactions).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return trash, nil
+}
+
+func (r *trashRepository) FindDeletedWarehouse(id uint) (*models.Warehouse, error) {
+	var warehouse models.Warehouse
+
+	if err := r.deleted().First(&warehouse, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &warehouse, nil
+}
+
+func (r *trashRepository) FindDeletedProduct(id uint) (*models.Product, error) {
+	var product models.Product
+
+	if err := r.deleted().First(&product, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+func (r *trashRepository) FindDeletedInventory(id uint) (*models.Inventory, error) {
+	var inventory models.Inventory
+
+	if err := r.deleted().First(&inventory, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &inventory, nil
+}
+
+func (r *trashRepository) FindDeletedTransaction(id uint) (*models.Transaction, error) {
+	var transaction models.Transaction
+
+	if err := r.deleted().First(&transaction, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
+// 창고 복원 ( 창고와 함께 삭제된 재고와 재고내역도 복원, 제품이 삭제된 재고는 제외 )
+func (r *trashRepository) RestoreWarehouse(warehouse *models.Warehouse) (*models.TrashCount, error) {
+	count := &models.TrashCount{}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := restore(tx, &models.Warehouse{}, "id = ?", warehouse.ID); err != nil {
+			return err
+		}
+		count.Warehouses = 1
+
+		inventories := tx.Unscoped().Model(&models.Inventory{}).
+			Where("warehouse_id = ? AND deleted_at >= ?", warehouse.ID, warehouse.DeletedAt.Time).
+			Where("product_id IN (?)", tx.Model(&models.Product{}).Select("id"))
+
+		return restoreInventories(tx, inventories, warehouse.DeletedAt.Time, count)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+// 제품 복원 ( 제품과 함께 삭제된 재고와 재고내역도 복원, 창고가 삭제된 재고는 제외 )
+func (r *trashRepository) RestoreProduct(product *models.Product) (*models.TrashCount, error) {
+	count := &models.TrashCount{}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := restore(tx, &models.Product{}, "id = ?", product.ID); err != nil {
+			return err
+		}
+		count.Products = 1
+
+		inventories := tx.Unscoped().Model(&models.Inventory{}).
+			Where("product_id = ? AND deleted_at >= ?", product.ID, product.DeletedAt.Time).
+			Where("warehouse_id IN (?)", tx.Model(&models.Warehouse{}).Select("id"))
+
+		return restoreInventories(tx, inventories, product.DeletedAt.Time, count)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+// 재고 복원 ( 재고와 함께 삭제된 재고내역도 복원 )
+func (r *trashRepository) RestoreInventory(inventory *models.Inventory) (*models.TrashCount, error) {
+	count := &models.TrashCount{}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		inventories := tx.Unscoped().Model(&models.Inventory{}).Where("id = ?", inventory.ID)
+		return restoreInventories(tx, inventories, inventory.DeletedAt.Time, count)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+// 조회한 재고와, 그 재고의 재고내역 중 deletedAt 이후 함께 삭제된 재고내역 복원
+func restoreInventories(tx *gorm.DB, inventories *gorm.DB, deletedAt time.Time, count *models.TrashCount) error {
+	var inventoryIDs []uint
+	if err := inventories.Pluck("id", &inventoryIDs).Error; err != nil {
+		return err
+	}
+	if len(inventoryIDs) == 0 {
+		return nil
+	}
+
+	if err := restore(tx, &models.Inventory{}, "id IN ?", inventoryIDs); err != nil {
+		return err
+	}
+	count.Inventories = len(inventoryIDs)
+
+	result := tx.Unscoped().Model(&models.Transaction{}).
+		Where("inventory_id IN ? AND deleted_at >= ?", inventoryIDs, deletedAt).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	count.Transactions = int(result.RowsAffected)
+
+	return nil
+}
+
+func restore(tx *gorm.DB, model interface{}, query string, args ...interface{}) error {
+	return tx.Unscoped().Model(model).Where(query, args...).Update("deleted_at", nil).Error
+}
+
+// 영구 삭제 전 참조 여부를 확인할 테이블과 컬럼
+// - 참조하는 외래 키가 OnDelete:CASCADE 라서 삭제 오류로는 확인할 수 없으므로 직접 확인
+// - 삭제(soft delete)된 참조 레코드도 함께 지워지므로 포함해서 확인
+type purgeReference struct {
+	model   interface{}
+	columns []string
+}
+
+var (
+	warehousePurgeReferences = []purgeReference{
+		{&models.Inventory{}, []string{"warehouse_id"}},
+		{&models.Order{}, []string{"warehouse_id"}},
+		{&models.PurchaseOrder{}, []string{"warehouse_id"}},
+		{&models.TransferOrder{}, []string{"source_warehouse_id", "destination_warehouse_id"}},
+		{&models.Stocktake{}, []string{"warehouse_id"}},
+	}
+	productPurgeReferences = []purgeReference{
+		{&models.Inventory{}, []string{"product_id"}},
+		{&models.Product{}, []string{"parent_id"}},
+		{&models.OrderItem{}, []string{"product_id"}},
+		{&models.PurchaseOrderLine{}, []string{"product_id"}},
+		{&models.TransferOrderItem{}, []string{"product_id"}},
+		{&models.SerialNumber{}, []string{"product_id"}},
+	}
+	inventoryPurgeReferences = []purgeReference{
+		{&models.Transaction{}, []string{"inventory_id"}},
+		{&models.Reservation{}, []string{"inventory_id"}},
+		{&models.StocktakeLine{}, []string{"inventory_id"}},
+	}
+)
+
+// before 이전에 삭제된 레코드를 영구 삭제 ( 재고 → 제품 → 창고 순서 )
+// - 다른 데이터가 참조하는 레코드는 건너뜀 ( 재고내역이 있는 재고도 포함 )
+// - 재고내역은 취소만 가능한 원장이므로 영구 삭제하지 않고 건너뜀
+func (r *trashRepository) Purge(before time.Time) (*models.TrashPurgeResult, error) {
+	result := &models.TrashPurgeResult{Before: before}
+
+	var transactionCount int64
+	if err := r.deleted().Model(&models.Transaction{}).Where("deleted_at < ?", before).Count(&transactionCount).Error; err != nil {
+		return nil, err
+	}
+	result.Skipped.Transactions = int(transactionCount)
+
+	for _, target := range []struct {
+		model      interface{}
+		references []purgeReference
+		purged     *int
+		skipped    *int
+	}{
+		{&models.Inventory{}, inventoryPurgeReferences, &result.Purged.Inventories, &result.Skipped.Inventories},
+		{&models.Product{}, productPurgeReferences, &result.Purged.Products, &result.Skipped.Products},
+		{&models.Warehouse{}, warehousePurgeReferences, &result.Purged.Warehouses, &result.Skipped.Warehouses},
+	} {
+		var ids []uint
+		if err := r.deleted().Model(target.model).Where("deleted_at < ?", before).Pluck("id", &ids).Error; err != nil {
+			return nil, err
+		}
+
+		// 참조 확인과 삭제를 한 건씩 같은 DB 트랜잭션으로 처리 ( 삭제 오류는 건너뛴 건수로 기록 )
+		for _, id := range ids {
+			purged := false
+			var checkErr error
+			r.db.Transaction(func(tx *gorm.DB) error {
+				referenced, err := isReferenced(tx, target.references, id)
+				if err != nil {
+					checkErr = err
+					return err
+				}
+				if referenced {
+					return nil
+				}
+
+				if err := tx.Unscoped().Delete(target.model, id).Error; err != nil {
+					return err
+				}
+				purged = true
+				return nil
+			})
+			if checkErr != nil {
+				return nil, checkErr
+			}
+
+			if purged {
+				*target.purged++
+			} else {
+				*target.skipped++
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// id 를 참조하는 레코드가 하나라도 있는지 확인
+func isReferenced(tx *gorm.DB, references []purgeReference, id uint) (bool, error) {
+	for _, reference := range references {
+		for _, column := range reference.columns {
+			var count int64
+			if err := tx.Unscoped().Model(reference.model).Where(column+" = ?", id).Limit(1).Count(&count).Error; err != nil {
+				return false, err
+			}
+			if count > 0 {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -11,8 +11,10 @@ import (
 type WarehouseRepository interface {
 	FindByID(id uint) (*models.Warehouse, error)
 	FindAll(search_filter map[string]interface{}) ([]models.Warehouse, error)
+	FindPage(search_filter map[string]interface{}, page models.PageQuery) ([]models.Warehouse, *models.Pagination, error)
 
 	Create(warehouse *models.Warehouse) (*models.Warehouse, error)
+	Update(id uint, updates map[string]interface{}) error
 
 	Delete(id uint) error
 	GetCountWithComparison() (int64, float64, error)
@@ -38,8 +40,8 @@ func (r *warehouseRepository) FindByID(id uint) (*models.Warehouse, error) {
 	return &warehouse, nil
 }
 
-func (r *warehouseRepository) FindAll(search_filter map[string]interface{}) ([]models.Warehouse, error) {
-	var warehouses []models.Warehouse
+// 검색 조건을 적용한 쿼리
+func (r *warehouseRepository) searchQuery(search_filter map[string]interface{}) *gorm.DB {
 	query := r.db
 
 	for key, value := range search_filter {
@@ -51,13 +53,40 @@ func (r *warehouseRepository) FindAll(search_filter map[string]interface{}) ([]m
 		}
 	}
 
-	if err := query.Preload("Inventories").Find(&warehouses).Error; err != nil {
+	return query
+}
+
+func (r *warehouseRepository) FindAll(search_filter map[string]interface{}) ([]models.Warehouse, error) {
+	var warehouses []models.Warehouse
+
+	if err := r.searchQuery(search_filter).Preload("Inventories").Find(&warehouses).Error; err != nil {
 		return nil, err
 	}
 
 	return warehouses, nil
 }
 
+// 검색 조건에 맞는 창고를 페이지 단위로 조회
+func (r *warehouseRepository) FindPage(search_filter map[string]interface{}, page models.PageQuery) ([]models.Warehouse, *models.Pagination, error) {
+	var warehouses []models.Warehouse
+
+	query, pagination, err := paginate(r.searchQuery(search_filter), &models.Warehouse{}, "warehouses", page)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := query.Preload("Inventories").Find(&warehouses).Error; err != nil {
+		return nil, nil, err
+	}
+
+	warehouses, err = trimPage(warehouses, pagination, (*models.Warehouse).SortValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return warehouses, pagination, nil
+}
+
 func (r *warehouseRepository) Create(warehouse *models.Warehouse) (*models.Warehouse, error) {
 	if err := r.db.Create(warehouse).Error; err != nil {
 		return nil, err
@@ -66,6 +95,11 @@ func (r *warehouseRepository) Create(warehouse *models.Warehouse) (*models.Wareh
 	return warehouse, nil
 }
 
+// 지정한 컬럼만 변경 ( 빈 값/0 도 반영되도록 map 사용 )
+func (r *warehouseRepository) Update(id uint, updates map[string]interface{}) error {
+	return r.db.Model(&models.Warehouse{}).Where("id = ?", id).Updates(updates).Error
+}
+
 func (r *warehouseRepository) Delete(id uint) error {
 	tx := r.db.Begin()
 	if tx.Error != nil {
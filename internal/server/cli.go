@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/jhphon0730/StockFlow/internal/models"
+)
+
+// 재고내역 정합성 검사 명령 ( 예: stockflow check-ledger -repair -warehouse_id=1 )
+func RunLedgerCheck(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("check-ledger", flag.ContinueOnError)
+	repair := flags.Bool("repair", false, "불일치 재고를 재고내역 수량으로 조정(ADJUST)")
+	warehouseID := flags.Uint("warehouse_id", 0, "검사할 창고 ID ( 0: 전체 )")
+	productID := flags.Uint("product_id", 0, "검사할 제품 ID ( 0: 전체 )")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	search_filter := make(map[string]interface{})
+	if *warehouseID != 0 {
+		search_filter["warehouse_id"] = strconv.FormatUint(uint64(*warehouseID), 10)
+	}
+	if *productID != 0 {
+		search_filter["product_id"] = strconv.FormatUint(uint64(*productID), 10)
+	}
+
+	run := ledgerService.Check
+	if *repair {
+		run = func(search_filter map[string]interface{}) (int, *models.LedgerCheckReport, error) {
+			return ledgerService.Repair(search_filter, ctx)
+		}
+	}
+
+	_, report, err := run(search_filter)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("검사한 재고: %d, 불일치: %d\n", report.InventoryCount, len(report.Mismatches))
+	failed := 0
+	for _, mismatch := range report.Mismatches {
+		fmt.Printf("- 재고 %d (%s / %s): 재고 수량 %d, 재고내역 수량 %d, 차이 %d",
+			mismatch.InventoryID, mismatch.WarehouseName, mismatch.SKU, mismatch.Quantity, mismatch.LedgerQuantity, mismatch.Difference)
+		switch {
+		case mismatch.RepairTransactionID != nil:
+			fmt.Printf(" → 보정 재고내역 %d", *mismatch.RepairTransactionID)
+		case mismatch.RepairError != "":
+			fmt.Printf(" → 보정 실패: %s", mismatch.RepairError)
+			failed++
+		}
+		fmt.Println()
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("보정하지 못한 재고가 있습니다 (%d건)", failed)
+	}
+
+	return nil
+}
@@ -19,21 +19,80 @@ var (
 	warehouseService    services.WarehouseService        = services.NewWarehouseService(warehouseRepository)
 	warehouseHandler    handlers.WarehouseHandler        = handlers.NewWarehouseHandler(warehouseService)
 
-	productRepository repositories.ProductRepository = repositories.NewProductRepository(DB)
-	productService    services.ProductService        = services.NewProductService(productRepository)
-	productHandler    handlers.ProductHandler        = handlers.NewProductHandler(productService)
+	categoryRepository repositories.CategoryRepository = repositories.NewCategoryRepository(DB)
+	productRepository  repositories.ProductRepository  = repositories.NewProductRepository(DB)
+	productService     services.ProductService         = services.NewProductService(productRepository, categoryRepository)
+	productHandler     handlers.ProductHandler         = handlers.NewProductHandler(productService)
+	categoryService    services.CategoryService        = services.NewCategoryService(categoryRepository, productRepository)
+	categoryHandler    handlers.CategoryHandler        = handlers.NewCategoryHandler(categoryService)
 
 	inventoryRepository repositories.InventoryRepository = repositories.NewInventoryRepository(DB)
-	inventoryService    services.InventoryService        = services.NewInventoryService(inventoryRepository)
+	inventoryService    services.InventoryService        = services.NewInventoryService(inventoryRepository, transactionRepository)
 	inventoryHandler    handlers.InventoryHandler        = handlers.NewInventoryHandler(inventoryService)
 
+	barcodeRepository repositories.BarcodeRepository = repositories.NewBarcodeRepository(DB)
+	barcodeService    services.BarcodeService        = services.NewBarcodeService(barcodeRepository, productRepository, inventoryRepository)
+	barcodeHandler    handlers.BarcodeHandler        = handlers.NewBarcodeHandler(barcodeService)
+
+	lotRepository repositories.LotRepository = repositories.NewLotRepository(DB)
+
+	costLayerRepository repositories.CostLayerRepository = repositories.NewCostLayerRepository(DB)
+
+	stockAlertRepository repositories.StockAlertRepository = repositories.NewStockAlertRepository(DB)
+	stockAlertService    services.StockAlertService        = services.NewStockAlertService(stockAlertRepository)
+	stockAlertHandler    handlers.StockAlertHandler        = handlers.NewStockAlertHandler(stockAlertService)
+
+	binLocationRepository repositories.BinLocationRepository = repositories.NewBinLocationRepository(DB)
+	binLocationService    services.BinLocationService        = services.NewBinLocationService(binLocationRepository, warehouseRepository, inventoryRepository)
+	binLocationHandler    handlers.BinLocationHandler        = handlers.NewBinLocationHandler(binLocationService)
+
+	labelService services.LabelService = services.NewLabelService(productRepository, inventoryRepository, binLocationRepository, warehouseRepository)
+	labelHandler handlers.LabelHandler = handlers.NewLabelHandler(labelService)
+
+	serialNumberRepository repositories.SerialNumberRepository = repositories.NewSerialNumberRepository(DB)
+	serialNumberService    services.SerialNumberService        = services.NewSerialNumberService(serialNumberRepository)
+	serialNumberHandler    handlers.SerialNumberHandler        = handlers.NewSerialNumberHandler(serialNumberService)
+
 	transactionRepository repositories.TransactionRepository = repositories.NewTransactionRepository(DB)
-	transactionService    services.TransactionService        = services.NewTransactionService(transactionRepository, inventoryRepository)
+	transactionService    services.TransactionService        = services.NewTransactionService(transactionRepository, inventoryRepository, warehouseRepository, lotRepository, serialNumberRepository, binLocationRepository, stockAlertRepository, costLayerRepository)
 	transactionHandler    handlers.TransactionHandler        = handlers.NewTransactionHandler(transactionService)
 
-	dashboardService services.DashboardService = services.NewDashboardService(productRepository, inventoryRepository, warehouseRepository, transactionRepository)
+	ledgerService services.LedgerService = services.NewLedgerService(inventoryRepository, transactionRepository, transactionService)
+	ledgerHandler handlers.LedgerHandler = handlers.NewLedgerHandler(ledgerService)
+
+	trashRepository repositories.TrashRepository = repositories.NewTrashRepository(DB)
+	trashService    services.TrashService        = services.NewTrashService(trashRepository, warehouseRepository, productRepository, inventoryRepository)
+	trashHandler    handlers.TrashHandler        = handlers.NewTrashHandler(trashService)
+
+	orderRepository repositories.OrderRepository = repositories.NewOrderRepository(DB)
+	orderService    services.OrderService        = services.NewOrderService(orderRepository, inventoryRepository, transactionRepository, reservationRepository, transactionService)
+	orderHandler    handlers.OrderHandler        = handlers.NewOrderHandler(orderService)
+
+	transferOrderRepository repositories.TransferOrderRepository = repositories.NewTransferOrderRepository(DB)
+	transferOrderService    services.TransferOrderService        = services.NewTransferOrderService(transferOrderRepository, inventoryRepository, transactionRepository, transactionService)
+	transferOrderHandler    handlers.TransferOrderHandler        = handlers.NewTransferOrderHandler(transferOrderService)
+
+	supplierRepository      repositories.SupplierRepository      = repositories.NewSupplierRepository(DB)
+	purchaseOrderRepository repositories.PurchaseOrderRepository = repositories.NewPurchaseOrderRepository(DB)
+	supplierService         services.SupplierService             = services.NewSupplierService(supplierRepository, purchaseOrderRepository)
+	supplierHandler         handlers.SupplierHandler             = handlers.NewSupplierHandler(supplierService)
+	purchaseOrderService    services.PurchaseOrderService        = services.NewPurchaseOrderService(purchaseOrderRepository, supplierRepository, inventoryRepository, transactionRepository, transactionService)
+	purchaseOrderHandler    handlers.PurchaseOrderHandler        = handlers.NewPurchaseOrderHandler(purchaseOrderService)
+
+	stocktakeRepository repositories.StocktakeRepository = repositories.NewStocktakeRepository(DB)
+	stocktakeService    services.StocktakeService        = services.NewStocktakeService(stocktakeRepository, warehouseRepository, inventoryRepository, binLocationRepository, transactionRepository, transactionService)
+	stocktakeHandler    handlers.StocktakeHandler        = handlers.NewStocktakeHandler(stocktakeService)
+
+	reservationRepository repositories.ReservationRepository = repositories.NewReservationRepository(DB)
+	reservationService    services.ReservationService        = services.NewReservationService(reservationRepository, inventoryRepository)
+	reservationHandler    handlers.ReservationHandler        = handlers.NewReservationHandler(reservationService)
+
+	dashboardService services.DashboardService = services.NewDashboardService(productRepository, inventoryRepository, warehouseRepository, transactionRepository, transferOrderRepository)
 	dashboardHandler handlers.DashboardHandler = handlers.NewDashboardHandler(dashboardService)
 
+	idempotencyKeyRepository repositories.IdempotencyKeyRepository = repositories.NewIdempotencyKeyRepository(DB)
+	idempotencyMiddleware    gin.HandlerFunc                       = middleware.IdempotencyMiddleware(idempotencyKeyRepository)
+
 	wsManager ws.WebSocketManager = ws.GetManager()
 	wsHandler handlers.WebSocketHandler = handlers.NewWebSocketHandler(wsManager)
 )
@@ -54,6 +113,8 @@ func (s *Server) RegisterWarehouseRoutes(router *gin.RouterGroup) {
 	router.GET("", warehouseHandler.GetAllWarehouses)
 	router.POST("", warehouseHandler.CreateWarehouse)
 	router.GET("/:id", warehouseHandler.GetWarehouse)
+	router.PUT("/:id", warehouseHandler.UpdateWarehouse)
+	router.PATCH("/:id", warehouseHandler.UpdateWarehouse)
 	router.DELETE("/:id", warehouseHandler.DeleteWarehouse)
 }
 
@@ -61,21 +122,135 @@ func (s *Server) RegisterProductRoutes(router *gin.RouterGroup) {
 	router.GET("", productHandler.GetAllProducts)
 	router.POST("", productHandler.CreateProduct)
 	router.GET("/:id", productHandler.GetProduct)
+	router.PUT("/:id", productHandler.UpdateProduct)
+	router.PATCH("/:id", productHandler.UpdateProduct)
 	router.DELETE("/:id", productHandler.DeleteProduct)
+	router.PUT("/:id/units", productHandler.UpdateProductUnits)
+	router.PUT("/:id/attributes", productHandler.UpdateProductAttributes)
+}
+
+func (s *Server) RegisterCategoryRoutes(router *gin.RouterGroup) {
+	router.GET("", categoryHandler.GetAllCategories)
+	router.POST("", categoryHandler.CreateCategory)
+	router.GET("/:id", categoryHandler.GetCategory)
+	router.DELETE("/:id", categoryHandler.DeleteCategory)
 }
 
 func (s *Server) RegisterInventoryRoutes(router *gin.RouterGroup) {
 	router.GET("", inventoryHandler.GetAllInventory)
 	router.POST("", inventoryHandler.CreateInventory)
+	router.GET("/valuation", inventoryHandler.GetInventoryValuation)
+	router.GET("/stock-report", inventoryHandler.GetStockReport)
 	router.GET("/:id", inventoryHandler.GetInventory)
+	router.PUT("/:id", inventoryHandler.UpdateInventory)
+	router.PATCH("/:id", inventoryHandler.UpdateInventory)
 	router.DELETE("/:id", inventoryHandler.DeleteInventory)
+	router.PUT("/:id/thresholds", inventoryHandler.UpdateInventoryThresholds)
 }
 
 func (s *Server) RegisterTransactionRoutes(router *gin.RouterGroup) {
 	router.GET("", transactionHandler.GetAllTransactions)
 	router.POST("", transactionHandler.CreateTransaction)
+	router.POST("/batch", transactionHandler.CreateTransactionBatch)
+	router.POST("/transfer", transactionHandler.TransferTransaction)
 	router.GET("/:id", transactionHandler.GetTransaction)
-	router.DELETE("/:id", transactionHandler.DeleteTransaction)
+	router.POST("/:id/reverse", transactionHandler.ReverseTransaction)
+	router.DELETE("/:id", transactionHandler.ReverseTransaction) // 기존 삭제 요청도 취소로 처리
+}
+
+func (s *Server) RegisterOrderRoutes(router *gin.RouterGroup) {
+	router.GET("", orderHandler.GetAllOrders)
+	router.POST("", orderHandler.CreateOrder)
+	router.GET("/:id", orderHandler.GetOrder)
+	router.PUT("/:id/status", orderHandler.UpdateOrderStatus)
+	router.DELETE("/:id", orderHandler.DeleteOrder)
+}
+
+func (s *Server) RegisterTransferOrderRoutes(router *gin.RouterGroup) {
+	router.GET("", transferOrderHandler.GetAllTransferOrders)
+	router.POST("", transferOrderHandler.CreateTransferOrder)
+	router.GET("/:id", transferOrderHandler.GetTransferOrder)
+	router.POST("/:id/dispatch", transferOrderHandler.DispatchTransferOrder)
+	router.POST("/:id/receive", transferOrderHandler.ReceiveTransferOrder)
+	router.POST("/:id/cancel", transferOrderHandler.CancelTransferOrder)
+}
+
+func (s *Server) RegisterSupplierRoutes(router *gin.RouterGroup) {
+	router.GET("", supplierHandler.GetAllSuppliers)
+	router.POST("", supplierHandler.CreateSupplier)
+	router.GET("/:id", supplierHandler.GetSupplier)
+	router.PUT("/:id", supplierHandler.UpdateSupplier)
+	router.DELETE("/:id", supplierHandler.DeleteSupplier)
+}
+
+func (s *Server) RegisterPurchaseOrderRoutes(router *gin.RouterGroup) {
+	router.GET("", purchaseOrderHandler.GetAllPurchaseOrders)
+	router.POST("", purchaseOrderHandler.CreatePurchaseOrder)
+	router.GET("/:id", purchaseOrderHandler.GetPurchaseOrder)
+	router.PUT("/:id", purchaseOrderHandler.UpdatePurchaseOrder)
+	router.POST("/:id/order", purchaseOrderHandler.OrderPurchaseOrder)
+	router.POST("/:id/receive", purchaseOrderHandler.ReceivePurchaseOrder)
+	router.POST("/:id/cancel", purchaseOrderHandler.CancelPurchaseOrder)
+	router.DELETE("/:id", purchaseOrderHandler.DeletePurchaseOrder)
+}
+
+func (s *Server) RegisterStocktakeRoutes(router *gin.RouterGroup) {
+	router.GET("", stocktakeHandler.GetAllStocktakes)
+	router.POST("", stocktakeHandler.CreateStocktake)
+	router.GET("/:id", stocktakeHandler.GetStocktake)
+	router.POST("/:id/counts", stocktakeHandler.SubmitStocktakeCounts)
+	router.GET("/:id/variance", stocktakeHandler.GetStocktakeVariance)
+	router.POST("/:id/approve", stocktakeHandler.ApproveStocktake)
+	router.POST("/:id/cancel", stocktakeHandler.CancelStocktake)
+}
+
+func (s *Server) RegisterReservationRoutes(router *gin.RouterGroup) {
+	router.GET("", reservationHandler.GetAllReservations)
+	router.POST("", reservationHandler.CreateReservation)
+	router.GET("/:id", reservationHandler.GetReservation)
+	router.POST("/:id/release", reservationHandler.ReleaseReservation)
+}
+
+func (s *Server) RegisterStockAlertRoutes(router *gin.RouterGroup) {
+	router.GET("", stockAlertHandler.GetAllStockAlerts)
+	router.POST("/:id/acknowledge", stockAlertHandler.AcknowledgeStockAlert)
+}
+
+func (s *Server) RegisterBinLocationRoutes(router *gin.RouterGroup) {
+	router.GET("", binLocationHandler.GetAllBinLocations)
+	router.POST("", binLocationHandler.CreateBinLocation)
+	router.POST("/move", binLocationHandler.MoveBinStock)
+	router.GET("/:id", binLocationHandler.GetBinLocation)
+	router.DELETE("/:id", binLocationHandler.DeleteBinLocation)
+}
+
+func (s *Server) RegisterBarcodeRoutes(router *gin.RouterGroup) {
+	router.GET("", barcodeHandler.GetAllBarcodes)
+	router.POST("", barcodeHandler.CreateBarcode)
+	router.DELETE("/:id", barcodeHandler.DeleteBarcode)
+}
+
+func (s *Server) RegisterScanRoutes(router *gin.RouterGroup) {
+	router.GET("/:code", barcodeHandler.ScanCode)
+}
+
+func (s *Server) RegisterLabelRoutes(router *gin.RouterGroup) {
+	router.GET("/products/:id", labelHandler.GetProductLabel)
+	router.GET("/inventories/:id", labelHandler.GetInventoryLabel)
+	router.GET("/bins/:id", labelHandler.GetBinLocationLabel)
+	router.POST("/sheet", labelHandler.CreateLabelSheet)
+}
+
+func (s *Server) RegisterSerialNumberRoutes(router *gin.RouterGroup) {
+	router.GET("/:serial", serialNumberHandler.GetSerialNumber)
+}
+
+func (s *Server) RegisterAdminRoutes(router *gin.RouterGroup) {
+	router.GET("/ledger/check", ledgerHandler.CheckLedger)
+	router.POST("/ledger/repair", ledgerHandler.RepairLedger)
+	router.GET("/trash", trashHandler.GetTrash)
+	router.POST("/trash/purge", trashHandler.PurgeTrash)
+	router.POST("/trash/:type/:id/restore", trashHandler.RestoreTrash)
 }
 
 func (s *Server) RegisterWSRoutes(router *gin.RouterGroup) {
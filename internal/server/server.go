@@ -36,8 +36,8 @@ func (s *Server) Init(PORT string) {
 	s.router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"http://192.168.0.5:3000", "http://localhost:3000", "*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", middleware.IDEMPOTENCY_KEY_HEADER},
+		ExposeHeaders:    []string{"Content-Length", middleware.IDEMPOTENCY_REPLAYED_HEADER},
 		AllowCredentials: true,
 		AllowOriginFunc: func(origin string) bool {
 			return true
@@ -58,6 +58,11 @@ func (s *Server) Init(PORT string) {
 	}
 }
 
+// 서버와 함께 실행되는 백그라운드 작업 ( ctx 취소 시 종료 )
+func (s *Server) StartBackgroundJobs(ctx context.Context) {
+	reservationService.StartExpirySweeper(ctx, time.Minute)
+}
+
 func (s *Server) Run() error {
 	/* http://192.168.0.5:8080/
 	s.router.Use(static.Serve("/", static.LocalFile("./front/dist", true)))
@@ -79,20 +84,62 @@ func (s *Server) Run() error {
 
 		// anoher routes
 		warehouse_api := api.Group("/warehouses")
-		warehouse_api.Use(middleware.AuthMiddleware())
+		warehouse_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
 		s.RegisterWarehouseRoutes(warehouse_api)
 		product_api := api.Group("/products")
-		product_api.Use(middleware.AuthMiddleware())
+		product_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
 		s.RegisterProductRoutes(product_api)
+		category_api := api.Group("/categories")
+		category_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
+		s.RegisterCategoryRoutes(category_api)
+		barcode_api := api.Group("/barcodes")
+		barcode_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
+		s.RegisterBarcodeRoutes(barcode_api)
+		scan_api := api.Group("/scan")
+		scan_api.Use(middleware.AuthMiddleware())
+		s.RegisterScanRoutes(scan_api)
+		label_api := api.Group("/labels")
+		label_api.Use(middleware.AuthMiddleware())
+		s.RegisterLabelRoutes(label_api)
 		inventory_api := api.Group("/inventories")
-		inventory_api.Use(middleware.AuthMiddleware())
+		inventory_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
 		s.RegisterInventoryRoutes(inventory_api)
 		transaction_api := api.Group("/transactions")
-		transaction_api.Use(middleware.AuthMiddleware())
+		transaction_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
 		s.RegisterTransactionRoutes(transaction_api)
+		order_api := api.Group("/orders")
+		order_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
+		s.RegisterOrderRoutes(order_api)
+		transfer_order_api := api.Group("/transfer-orders")
+		transfer_order_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
+		s.RegisterTransferOrderRoutes(transfer_order_api)
+		supplier_api := api.Group("/suppliers")
+		supplier_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
+		s.RegisterSupplierRoutes(supplier_api)
+		purchase_order_api := api.Group("/purchase-orders")
+		purchase_order_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
+		s.RegisterPurchaseOrderRoutes(purchase_order_api)
+		stocktake_api := api.Group("/stocktakes")
+		stocktake_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
+		s.RegisterStocktakeRoutes(stocktake_api)
+		reservation_api := api.Group("/reservations")
+		reservation_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
+		s.RegisterReservationRoutes(reservation_api)
+		stock_alert_api := api.Group("/stock-alerts")
+		stock_alert_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
+		s.RegisterStockAlertRoutes(stock_alert_api)
+		bin_location_api := api.Group("/bin-locations")
+		bin_location_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
+		s.RegisterBinLocationRoutes(bin_location_api)
+		serial_number_api := api.Group("/serials")
+		serial_number_api.Use(middleware.AuthMiddleware(), idempotencyMiddleware)
+		s.RegisterSerialNumberRoutes(serial_number_api)
 		dashboard_api := api.Group("/dashboard")
 		dashboard_api.Use(middleware.AuthMiddleware())
 		s.RegisterDashboardRoutes(dashboard_api)
+		admin_api := api.Group("/admin")
+		admin_api.Use(middleware.AdminMiddleware(), idempotencyMiddleware)
+		s.RegisterAdminRoutes(admin_api)
 		ws_api := api.Group("/ws")
 		s.RegisterWSRoutes(ws_api)
 	}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+
+	"gorm.io/gorm"
+
+	"errors"
+	"net/http"
+)
+
+type BarcodeService interface {
+	FindAll(search_filter map[string]interface{}) (int, []models.Barcode, error)
+	Create(barcode *models.Barcode) (int, *models.Barcode, error)
+	Delete(id uint) (int, error)
+	Scan(code string) (int, *models.ScanResult, error)
+}
+
+type barcodeService struct {
+	barcodeRepository   repositories.BarcodeRepository
+	productRepository   repositories.ProductRepository
+	inventoryRepository repositories.InventoryRepository
+}
+
+func NewBarcodeService(
+	barcodeRepository repositories.BarcodeRepository,
+	productRepository repositories.ProductRepository,
+	inventoryRepository repositories.InventoryRepository,
+) BarcodeService {
+	return &barcodeService{
+		barcodeRepository:   barcodeRepository,
+		productRepository:   productRepository,
+		inventoryRepository: inventoryRepository,
+	}
+}
+
+func (s *barcodeService) FindAll(search_filter map[string]interface{}) (int, []models.Barcode, error) {
+	barcodes, err := s.barcodeRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, barcodes, nil
+}
+
+func (s *barcodeService) Create(barcode *models.Barcode) (int, *models.Barcode, error) {
+	product, err := s.productRepository.FindByID(barcode.ProductID)
+	if err != nil {
+		return http.StatusBadRequest, nil, errors.New("존재하지 않는 제품입니다")
+	}
+
+	if _, err := product.UnitFactor(barcode.Unit); err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+
+	if _, err := s.barcodeRepository.FindByCode(barcode.Code); err == nil {
+		return http.StatusConflict, nil, errors.New("이미 등록된 바코드입니다")
+	}
+
+	createdBarcode, err := s.barcodeRepository.Create(barcode)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusCreated, createdBarcode, nil
+}
+
+func (s *barcodeService) Delete(id uint) (int, error) {
+	if err := s.barcodeRepository.Delete(id); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
+
+// 스캔한 코드를 제품과 창고별 재고로 변환 ( 등록된 바코드가 없으면 SKU 로 조회 )
+func (s *barcodeService) Scan(code string) (int, *models.ScanResult, error) {
+	result := &models.ScanResult{Code: code}
+
+	barcode, err := s.barcodeRepository.FindByCode(code)
+	switch {
+	case err == nil:
+		result.Barcode = barcode
+		result.Product = barcode.Product
+		result.Unit = barcode.Unit
+		barcode.Product = nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		product, err := s.productRepository.FindBySKU(code)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return http.StatusNotFound, nil, errors.New("등록되지 않은 바코드입니다")
+			}
+			return http.StatusInternalServerError, nil, err
+		}
+		result.Product = product
+	default:
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if result.Unit == "" {
+		result.Unit = result.Product.BaseUnit
+	}
+
+	factor, err := result.Product.UnitFactor(result.Unit)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+	result.UnitFactor = factor
+
+	inventories, err := s.inventoryRepository.FindAll(map[string]interface{}{"product_id": result.Product.ID})
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	for i := range inventories {
+		inventories[i].Product = nil
+		result.TotalQuantity += inventories[i].Quantity
+	}
+	result.Inventories = inventories
+
+	return http.StatusOK, result, nil
+}
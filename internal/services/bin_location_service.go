@@ -0,0 +1,173 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/pkg/redis"
+
+	"gorm.io/gorm"
+
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type BinLocationService interface {
+	FindAll(search_filter map[string]interface{}) (int, []models.BinLocation, error)
+	FindByID(id uint) (int, *models.BinLocation, error)
+	Create(binLocation *models.BinLocation) (int, *models.BinLocation, error)
+	Delete(id uint) (int, error)
+	Move(inventoryID, fromBinLocationID, toBinLocationID uint, quantity int, ctx context.Context) (int, []models.BinStock, error)
+}
+
+type binLocationService struct {
+	binLocationRepository repositories.BinLocationRepository
+	warehouseRepository   repositories.WarehouseRepository
+	inventoryRepository   repositories.InventoryRepository
+}
+
+func NewBinLocationService(binLocationRepository repositories.BinLocationRepository, warehouseRepository repositories.WarehouseRepository, inventoryRepository repositories.InventoryRepository) BinLocationService {
+	return &binLocationService{
+		binLocationRepository: binLocationRepository,
+		warehouseRepository:   warehouseRepository,
+		inventoryRepository:   inventoryRepository,
+	}
+}
+
+func (b *binLocationService) FindAll(search_filter map[string]interface{}) (int, []models.BinLocation, error) {
+	binLocations, err := b.binLocationRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, binLocations, nil
+}
+
+// 보관 위치와 위치에 보관 중인 재고 조회
+func (b *binLocationService) FindByID(id uint) (int, *models.BinLocation, error) {
+	binLocation, err := b.binLocationRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, binLocation, nil
+}
+
+func (b *binLocationService) Create(binLocation *models.BinLocation) (int, *models.BinLocation, error) {
+	if _, err := b.warehouseRepository.FindByID(binLocation.WarehouseID); err != nil {
+		return http.StatusBadRequest, nil, errors.New("존재하지 않는 창고입니다")
+	}
+
+	existing, err := b.binLocationRepository.FindAll(map[string]interface{}{"warehouse_id": binLocation.WarehouseID})
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+	for _, location := range existing {
+		if location.Code == binLocation.BuildCode() {
+			return http.StatusConflict, nil, fmt.Errorf("이미 존재하는 보관 위치입니다 (%s)", location.Code)
+		}
+	}
+
+	createdBinLocation, err := b.binLocationRepository.Create(binLocation)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusCreated, createdBinLocation, nil
+}
+
+// 재고가 남아 있지 않은 보관 위치만 삭제 가능
+func (b *binLocationService) Delete(id uint) (int, error) {
+	quantity, err := b.binLocationRepository.GetStockQuantity(id)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if quantity > 0 {
+		return http.StatusConflict, errors.New("재고가 남아 있는 보관 위치는 삭제할 수 없습니다")
+	}
+
+	if err := b.binLocationRepository.Delete(id); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
+
+// 같은 창고 안에서 보관 위치 간 재고 이동 ( 재고 수량은 변하지 않음 )
+func (b *binLocationService) Move(inventoryID, fromBinLocationID, toBinLocationID uint, quantity int, ctx context.Context) (int, []models.BinStock, error) {
+	var binStocks []models.BinStock
+	status := http.StatusOK
+
+	err := b.binLocationRepository.Transaction(func(tx *gorm.DB) error {
+		binLocationRepository := b.binLocationRepository.WithTx(tx)
+
+		inventory, err := b.inventoryRepository.WithTx(tx).FindByIDForUpdate(inventoryID)
+		if err != nil {
+			status = http.StatusBadRequest
+			return errors.New("존재하지 않는 재고입니다")
+		}
+
+		for _, binLocationID := range []uint{fromBinLocationID, toBinLocationID} {
+			if code, err := checkBinLocation(binLocationRepository, inventory, binLocationID); err != nil {
+				status = code
+				return err
+			}
+		}
+
+		from, err := binLocationRepository.FindStockForUpdate(inventoryID, fromBinLocationID)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		if from.Quantity < quantity {
+			status = http.StatusConflict
+			return fmt.Errorf("보관 위치 재고가 부족합니다 (현재 수량: %d, 요청 수량: %d)", from.Quantity, quantity)
+		}
+
+		to, err := binLocationRepository.FindStockForUpdate(inventoryID, toBinLocationID)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		if err := binLocationRepository.AddStockQuantity(inventoryID, fromBinLocationID, -quantity); err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		if err := binLocationRepository.AddStockQuantity(inventoryID, toBinLocationID, quantity); err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		from.Quantity -= quantity
+		to.Quantity += quantity
+		binStocks = []models.BinStock{*from, *to}
+
+		return nil
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+
+	return http.StatusOK, binStocks, nil
+}
+
+// 보관 위치가 재고와 같은 창고에 있는지 확인
+func checkBinLocation(binLocationRepository repositories.BinLocationRepository, inventory *models.Inventory, binLocationID uint) (int, error) {
+	binLocation, err := binLocationRepository.FindByID(binLocationID)
+	if err != nil {
+		return http.StatusBadRequest, errors.New("존재하지 않는 보관 위치입니다")
+	}
+
+	if binLocation.WarehouseID != inventory.WarehouseID {
+		return http.StatusBadRequest, fmt.Errorf("보관 위치(%s)가 재고의 창고에 속하지 않습니다", binLocation.Code)
+	}
+
+	return http.StatusOK, nil
+}
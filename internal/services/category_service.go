@@ -0,0 +1,125 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+
+	"errors"
+	"net/http"
+)
+
+type CategoryService interface {
+	FindAll(search_filter map[string]interface{}) (int, []models.Category, error)
+	FindByID(id uint) (int, *models.Category, error)
+	Create(category *models.Category) (int, *models.Category, error)
+	Delete(id uint) (int, error)
+}
+
+type categoryService struct {
+	categoryRepository repositories.CategoryRepository
+	productRepository  repositories.ProductRepository
+}
+
+func NewCategoryService(categoryRepository repositories.CategoryRepository, productRepository repositories.ProductRepository) CategoryService {
+	return &categoryService{
+		categoryRepository: categoryRepository,
+		productRepository:  productRepository,
+	}
+}
+
+// 검색 조건이 없으면 최상위 분류부터 트리로 구성해 반환
+func (s *categoryService) FindAll(search_filter map[string]interface{}) (int, []models.Category, error) {
+	categories, err := s.categoryRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if len(search_filter) > 0 {
+		return http.StatusOK, categories, nil
+	}
+
+	return http.StatusOK, buildCategoryTree(categories, nil), nil
+}
+
+func (s *categoryService) FindByID(id uint) (int, *models.Category, error) {
+	category, err := s.categoryRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, category, nil
+}
+
+func (s *categoryService) Create(category *models.Category) (int, *models.Category, error) {
+	if category.ParentID != nil {
+		if _, err := s.categoryRepository.FindByID(*category.ParentID); err != nil {
+			return http.StatusBadRequest, nil, errors.New("존재하지 않는 상위 분류입니다")
+		}
+	}
+
+	if _, err := s.categoryRepository.FindByParentAndName(category.ParentID, category.Name); err == nil {
+		return http.StatusConflict, nil, errors.New("같은 상위 분류에 이미 등록된 분류 이름입니다")
+	}
+
+	createdCategory, err := s.categoryRepository.Create(category)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusCreated, createdCategory, nil
+}
+
+// 하위 분류나 제품이 있는 분류는 삭제 불가
+func (s *categoryService) Delete(id uint) (int, error) {
+	category, err := s.categoryRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if len(category.Children) > 0 {
+		return http.StatusConflict, errors.New("하위 분류가 있는 분류는 삭제할 수 없습니다")
+	}
+
+	products, err := s.productRepository.FindAll(map[string]interface{}{"category_ids": []uint{id}})
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if len(products) > 0 {
+		return http.StatusConflict, errors.New("제품이 등록된 분류는 삭제할 수 없습니다")
+	}
+
+	if err := s.categoryRepository.Delete(id); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
+
+// 상위 분류 ID 가 parentID 인 분류부터 재귀적으로 하위 분류를 연결
+func buildCategoryTree(categories []models.Category, parentID *uint) []models.Category {
+	tree := []models.Category{}
+	for _, category := range categories {
+		if (parentID == nil) != (category.ParentID == nil) || (parentID != nil && *parentID != *category.ParentID) {
+			continue
+		}
+
+		id := category.ID
+		category.Children = buildCategoryTree(categories, &id)
+		tree = append(tree, category)
+	}
+
+	return tree
+}
+
+// 분류와 모든 하위 분류의 ID
+func collectCategoryIDs(categories []models.Category, rootID uint) []uint {
+	ids := []uint{rootID}
+	for _, category := range categories {
+		if category.ParentID != nil && *category.ParentID == rootID {
+			ids = append(ids, collectCategoryIDs(categories, category.ID)...)
+		}
+	}
+
+	return ids
+}
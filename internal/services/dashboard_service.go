@@ -13,6 +13,7 @@ type DashboardService interface {
 	GetWarehouseCount() (int, int64, float64, error)
 	GetRecentTransactions(limit int) (int, []models.Transaction, error)
 	GetZeroQuantityInventory() (int, int64, error)
+	GetInTransitQuantities() (int, []models.InTransitQuantity, error)
 }
 
 type dashboardService struct {
@@ -20,6 +21,7 @@ type dashboardService struct {
 	inventoryRepository repositories.InventoryRepository
 	warehouseRepository repositories.WarehouseRepository
 	transactionRepository repositories.TransactionRepository
+	transferOrderRepository repositories.TransferOrderRepository
 }
 
 func NewDashboardService(
@@ -27,12 +29,14 @@ func NewDashboardService(
 	inventoryRepository repositories.InventoryRepository,
 	warehouseRepository repositories.WarehouseRepository,
 	transactionRepository repositories.TransactionRepository,
+	transferOrderRepository repositories.TransferOrderRepository,
 ) DashboardService {
 	return &dashboardService{
 		productRepository: productRepository,
 		inventoryRepository: inventoryRepository,
 		warehouseRepository: warehouseRepository,
 		transactionRepository: transactionRepository,
+		transferOrderRepository: transferOrderRepository,
 	}
 }
 
@@ -76,3 +80,12 @@ func (s *dashboardService) GetZeroQuantityInventory() (int, int64, error) {
 
 	return http.StatusOK, count, nil
 }
+
+func (s *dashboardService) GetInTransitQuantities() (int, []models.InTransitQuantity, error) {
+	quantities, err := s.transferOrderRepository.GetInTransitQuantities()
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, quantities, nil
+}
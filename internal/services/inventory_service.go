@@ -5,24 +5,36 @@ import (
 	"github.com/jhphon0730/StockFlow/internal/repositories"
 	"github.com/jhphon0730/StockFlow/pkg/redis"
 
+	"gorm.io/gorm"
+
 	"net/http"
 	"context"
+	"errors"
+	"time"
 )
 
 type InventoryService interface {
-	FindAll(ctx context.Context, search_filter map[string]interface{}) (int, []models.Inventory, error)
+	FindAll(ctx context.Context, search_filter map[string]interface{}, page models.PageQuery) (int, []models.Inventory, *models.Pagination, error)
 	FindByID(id uint) (int, *models.Inventory, error)
 	Create(inventory *models.Inventory, ctx context.Context) (int, *models.Inventory, error)
 	Delete(id uint, ctx context.Context) (int, error)
+	UpdateThresholds(id uint, minQuantity, reorderPoint, maxQuantity int, ctx context.Context) (int, *models.Inventory, error)
+	Update(id uint, thresholds map[string]int, ctx context.Context) (int, *models.Inventory, error)
+	GetValuation(search_filter map[string]interface{}) (int, *models.ValuationReport, error)
+	FindAllAsOf(search_filter map[string]interface{}, asOf time.Time) (int, []models.Inventory, error)
+	FindPageAsOf(search_filter map[string]interface{}, page models.PageQuery, asOf time.Time) (int, []models.Inventory, *models.Pagination, error)
+	GetStockReport(search_filter map[string]interface{}, asOf time.Time) (int, *models.StockReport, error)
 }
 
 type inventoryService struct {
-	inventoryRepository repositories.InventoryRepository
+	inventoryRepository   repositories.InventoryRepository
+	transactionRepository repositories.TransactionRepository
 }
 
-func NewInventoryService(inventoryRepository repositories.InventoryRepository) InventoryService {
+func NewInventoryService(inventoryRepository repositories.InventoryRepository, transactionRepository repositories.TransactionRepository) InventoryService {
 	return &inventoryService{
-		inventoryRepository: inventoryRepository,
+		inventoryRepository:   inventoryRepository,
+		transactionRepository: transactionRepository,
 	}
 }
 
@@ -35,31 +47,35 @@ func (i *inventoryService) getInventoryRedis(ctx context.Context) redis.Inventor
 	return inventoryRedis
 }
 
-func (i *inventoryService) FindAll(ctx context.Context, search_filter map[string]interface{}) (int, []models.Inventory, error) {
+// 검색 조건이 없는 목록은 페이지 조건별로 캐시
+func (i *inventoryService) FindAll(ctx context.Context, search_filter map[string]interface{}, page models.PageQuery) (int, []models.Inventory, *models.Pagination, error) {
 	inventoryRedis := i.getInventoryRedis(ctx)
 
 	if inventoryRedis != nil && len(search_filter) == 0 {
-		inventories, err := inventoryRedis.GetInventoryCache(ctx)
-		if err == nil && len(inventories) > 0 {
-			return http.StatusOK, inventories, nil
+		inventories, pagination, err := inventoryRedis.GetInventoryCache(ctx, page)
+		if err == nil && pagination != nil {
+			return http.StatusOK, inventories, pagination, nil
 		}
 	}
 
-	inventories, err := i.inventoryRepository.FindAll(search_filter)
+	inventories, pagination, err := i.inventoryRepository.FindPage(search_filter, page)
 	if err != nil {
-		return http.StatusInternalServerError, nil, err
+		return http.StatusInternalServerError, nil, nil, err
 	}
 
 	if inventoryRedis != nil && len(search_filter) == 0 {
-		_ = inventoryRedis.SetInventoryCache(ctx, inventories)
+		_ = inventoryRedis.SetInventoryCache(ctx, page, inventories, pagination)
 	}
 
-	return http.StatusOK, inventories, nil
+	return http.StatusOK, inventories, pagination, nil
 }
 
 func (i *inventoryService) FindByID(id uint) (int, *models.Inventory, error) {
 	inventory, err := i.inventoryRepository.FindByID(id)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusNotFound, nil, errors.New("존재하지 않는 재고입니다")
+		}
 		return http.StatusInternalServerError, nil, err
 	}
 
@@ -87,3 +103,204 @@ func (i *inventoryService) Delete(id uint, ctx context.Context) (int, error) {
 
 	return http.StatusOK, nil
 }
+
+// 재고 수정 ( 입력한 임계치만 변경하고, 기존 임계치와 합친 결과가 유효한 경우에만 반영 )
+// - 조회 후 변경하지 않고 한 번의 조건부 UPDATE 로 처리해서 동시 요청이 서로의 변경을 덮어쓰지 않도록 처리
+func (i *inventoryService) Update(id uint, thresholds map[string]int, ctx context.Context) (int, *models.Inventory, error) {
+	if len(thresholds) == 0 {
+		return i.FindByID(id)
+	}
+
+	updated, err := i.inventoryRepository.UpdateThresholdsIfValid(id, thresholds)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if !updated {
+		if _, err := i.inventoryRepository.FindByID(id); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return http.StatusNotFound, nil, errors.New("존재하지 않는 재고입니다")
+			}
+			return http.StatusInternalServerError, nil, err
+		}
+		return http.StatusBadRequest, nil, errors.New("임계치는 최소 수량, 재주문점, 최대 수량 순서여야 합니다 ( 0 은 미설정 )")
+	}
+
+	redis.RestoreRedisData(ctx)
+
+	return i.FindByID(id)
+}
+
+// 재고 임계치 변경 ( 이후 재고내역부터 알림 평가에 반영 )
+func (i *inventoryService) UpdateThresholds(id uint, minQuantity, reorderPoint, maxQuantity int, ctx context.Context) (int, *models.Inventory, error) {
+	if _, err := i.inventoryRepository.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusNotFound, nil, errors.New("존재하지 않는 재고입니다")
+		}
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if err := i.inventoryRepository.UpdateThresholds(id, minQuantity, reorderPoint, maxQuantity); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+
+	return i.FindByID(id)
+}
+
+// 재고별 보유 수량 x 단위 원가로 평가 금액을 계산하고 창고/제품 단위로 합산
+func (i *inventoryService) GetValuation(search_filter map[string]interface{}) (int, *models.ValuationReport, error) {
+	inventories, err := i.inventoryRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	report := &models.ValuationReport{
+		Items:      make([]models.InventoryValuation, 0, len(inventories)),
+		Warehouses: []models.ValuationSubtotal{},
+		Products:   []models.ValuationSubtotal{},
+	}
+	warehouseIndex := make(map[uint]int)
+	productIndex := make(map[uint]int)
+
+	for _, inventory := range inventories {
+		item := models.InventoryValuation{
+			InventoryID: inventory.ID,
+			WarehouseID: inventory.WarehouseID,
+			ProductID:   inventory.ProductID,
+			CostMethod:  models.COST_METHOD_FIFO,
+			Quantity:    inventory.Quantity,
+			UnitCost:    inventory.AverageCost,
+			TotalValue:  float64(inventory.Quantity) * inventory.AverageCost,
+		}
+		if inventory.Warehouse != nil {
+			item.WarehouseName = inventory.Warehouse.Name
+		}
+		if inventory.Product != nil {
+			item.ProductName = inventory.Product.Name
+			item.SKU = inventory.Product.SKU
+			if inventory.Product.CostMethod != "" {
+				item.CostMethod = inventory.Product.CostMethod
+			}
+		}
+		report.Items = append(report.Items, item)
+		report.TotalValue += item.TotalValue
+
+		index, ok := warehouseIndex[item.WarehouseID]
+		if !ok {
+			index = len(report.Warehouses)
+			warehouseIndex[item.WarehouseID] = index
+			report.Warehouses = append(report.Warehouses, models.ValuationSubtotal{ID: item.WarehouseID, Name: item.WarehouseName})
+		}
+		report.Warehouses[index].Quantity += item.Quantity
+		report.Warehouses[index].TotalValue += item.TotalValue
+
+		index, ok = productIndex[item.ProductID]
+		if !ok {
+			index = len(report.Products)
+			productIndex[item.ProductID] = index
+			report.Products = append(report.Products, models.ValuationSubtotal{ID: item.ProductID, Name: item.ProductName})
+		}
+		report.Products[index].Quantity += item.Quantity
+		report.Products[index].TotalValue += item.TotalValue
+	}
+
+	return http.StatusOK, report, nil
+}
+
+// 지정 시각까지의 재고 변경량 합계로 재고별 수량 계산 ( 이후 삭제된 재고와 재고내역도 포함 )
+// - 해당 시각 이후에 생성되고 그 전 재고내역이 없는 재고는 제외
+// - 수량만 재계산 ( 예약 수량은 0, 단위 원가는 현재 값 )
+func (i *inventoryService) FindAllAsOf(search_filter map[string]interface{}, asOf time.Time) (int, []models.Inventory, error) {
+	inventories, err := i.inventoryRepository.FindAllAsOf(search_filter, asOf)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return i.applyQuantitiesAsOf(inventories, asOf)
+}
+
+// 페이지 단위 시점 수량 조회 ( 페이지를 나눈 뒤 재계산하므로 제외된 재고만큼 Size 보다 적을 수 있음 )
+func (i *inventoryService) FindPageAsOf(search_filter map[string]interface{}, page models.PageQuery, asOf time.Time) (int, []models.Inventory, *models.Pagination, error) {
+	inventories, pagination, err := i.inventoryRepository.FindPageAsOf(search_filter, page, asOf)
+	if err != nil {
+		return http.StatusInternalServerError, nil, nil, err
+	}
+
+	status, inventories, err := i.applyQuantitiesAsOf(inventories, asOf)
+	if err != nil {
+		return status, nil, nil, err
+	}
+
+	return status, inventories, pagination, nil
+}
+
+func (i *inventoryService) applyQuantitiesAsOf(inventories []models.Inventory, asOf time.Time) (int, []models.Inventory, error) {
+	inventoryIDs := make([]uint, 0, len(inventories))
+	for _, inventory := range inventories {
+		inventoryIDs = append(inventoryIDs, inventory.ID)
+	}
+
+	quantities, err := i.transactionRepository.SumQuantityByInventoryIDsAsOf(inventoryIDs, asOf)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	result := make([]models.Inventory, 0, len(inventories))
+	for _, inventory := range inventories {
+		quantity, ok := quantities[inventory.ID]
+		if !ok && inventory.CreatedAt.After(asOf) {
+			continue
+		}
+
+		inventory.Quantity = quantity
+		inventory.ReservedQuantity = 0
+		inventory.AvailableQuantity = inventory.Quantity
+		result = append(result, inventory)
+	}
+
+	return http.StatusOK, result, nil
+}
+
+// 지정 시각 기준 창고별 재고 수량 보고서
+func (i *inventoryService) GetStockReport(search_filter map[string]interface{}, asOf time.Time) (int, *models.StockReport, error) {
+	status, inventories, err := i.FindAllAsOf(search_filter, asOf)
+	if err != nil {
+		return status, nil, err
+	}
+
+	report := &models.StockReport{
+		AsOf:       asOf,
+		Warehouses: []models.WarehouseStock{},
+	}
+	warehouseIndex := make(map[uint]int)
+
+	for _, inventory := range inventories {
+		item := models.StockReportItem{
+			InventoryID: inventory.ID,
+			ProductID:   inventory.ProductID,
+			Quantity:    inventory.Quantity,
+		}
+		if inventory.Product != nil {
+			item.ProductName = inventory.Product.Name
+			item.SKU = inventory.Product.SKU
+		}
+
+		index, ok := warehouseIndex[inventory.WarehouseID]
+		if !ok {
+			index = len(report.Warehouses)
+			warehouseIndex[inventory.WarehouseID] = index
+			warehouseStock := models.WarehouseStock{WarehouseID: inventory.WarehouseID, Items: []models.StockReportItem{}}
+			if inventory.Warehouse != nil {
+				warehouseStock.WarehouseName = inventory.Warehouse.Name
+			}
+			report.Warehouses = append(report.Warehouses, warehouseStock)
+		}
+		report.Warehouses[index].Items = append(report.Warehouses[index].Items, item)
+		report.Warehouses[index].TotalQuantity += item.Quantity
+		report.TotalQuantity += item.Quantity
+	}
+
+	return http.StatusOK, report, nil
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/pkg/label"
+
+	"gorm.io/gorm"
+
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type LabelService interface {
+	ProductLabel(id uint) (int, *label.Label, error)
+	InventoryLabel(id uint) (int, *label.Label, error)
+	BinLocationLabel(id uint) (int, *label.Label, error)
+	SheetLabels(warehouseID *uint, productIDs []uint, bins bool) (int, []label.Label, error)
+}
+
+type labelService struct {
+	productRepository     repositories.ProductRepository
+	inventoryRepository   repositories.InventoryRepository
+	binLocationRepository repositories.BinLocationRepository
+	warehouseRepository   repositories.WarehouseRepository
+}
+
+func NewLabelService(
+	productRepository repositories.ProductRepository,
+	inventoryRepository repositories.InventoryRepository,
+	binLocationRepository repositories.BinLocationRepository,
+	warehouseRepository repositories.WarehouseRepository,
+) LabelService {
+	return &labelService{
+		productRepository:     productRepository,
+		inventoryRepository:   inventoryRepository,
+		binLocationRepository: binLocationRepository,
+		warehouseRepository:   warehouseRepository,
+	}
+}
+
+// 조회 오류를 응답 코드로 변환 ( 없는 대상은 404 )
+func labelLookupStatus(err error) int {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// 제품 라벨: SKU ( 스캔 시 SKU 로 제품 조회 가능 )
+func productLabel(product *models.Product) label.Label {
+	return label.Label{
+		Value: product.SKU,
+		Lines: []string{product.Name, product.SKU},
+	}
+}
+
+// 재고 라벨: 제품 SKU + 창고 이름 ( Product 를 함께 조회한 경우 )
+func inventoryLabel(inventory *models.Inventory) label.Label {
+	l := label.Label{
+		Value: inventory.Product.SKU,
+		Lines: []string{inventory.Product.Name, inventory.Product.SKU},
+	}
+	if inventory.Warehouse != nil {
+		l.Lines = append(l.Lines, inventory.Warehouse.Name)
+	}
+
+	return l
+}
+
+// 보관 위치 라벨: 위치 코드 + 창고 이름
+func binLocationLabel(binLocation *models.BinLocation, warehouse *models.Warehouse) label.Label {
+	l := label.Label{
+		Value: binLocation.Code,
+		Lines: []string{binLocation.Code},
+	}
+	if warehouse != nil {
+		l.Lines = append(l.Lines, warehouse.Name)
+	}
+
+	return l
+}
+
+func (s *labelService) ProductLabel(id uint) (int, *label.Label, error) {
+	product, err := s.productRepository.FindByID(id)
+	if err != nil {
+		return labelLookupStatus(err), nil, err
+	}
+
+	l := productLabel(product)
+	return http.StatusOK, &l, nil
+}
+
+func (s *labelService) InventoryLabel(id uint) (int, *label.Label, error) {
+	inventory, err := s.inventoryRepository.FindByID(id)
+	if err != nil {
+		return labelLookupStatus(err), nil, err
+	}
+
+	l := inventoryLabel(inventory)
+	return http.StatusOK, &l, nil
+}
+
+func (s *labelService) BinLocationLabel(id uint) (int, *label.Label, error) {
+	binLocation, err := s.binLocationRepository.FindByID(id)
+	if err != nil {
+		return labelLookupStatus(err), nil, err
+	}
+
+	l := binLocationLabel(binLocation, binLocation.Warehouse)
+	return http.StatusOK, &l, nil
+}
+
+// 라벨 용지에 출력할 라벨 목록
+// - 창고 지정: 창고의 재고 라벨 ( 제품 목록이 있으면 해당 제품만, bins 이면 보관 위치 라벨 )
+// - 제품 목록만 지정: 제품 라벨
+func (s *labelService) SheetLabels(warehouseID *uint, productIDs []uint, bins bool) (int, []label.Label, error) {
+	var labels []label.Label
+
+	if warehouseID == nil {
+		for _, productID := range productIDs {
+			product, err := s.productRepository.FindByID(productID)
+			if err != nil {
+				return http.StatusBadRequest, nil, fmt.Errorf("존재하지 않는 제품입니다 (%d)", productID)
+			}
+			labels = append(labels, productLabel(product))
+		}
+
+		return http.StatusOK, labels, nil
+	}
+
+	warehouse, err := s.warehouseRepository.FindByID(*warehouseID)
+	if err != nil {
+		return http.StatusBadRequest, nil, errors.New("존재하지 않는 창고입니다")
+	}
+
+	if bins {
+		binLocations, err := s.binLocationRepository.FindAll(map[string]interface{}{"warehouse_id": warehouse.ID})
+		if err != nil {
+			return http.StatusInternalServerError, nil, err
+		}
+		for i := range binLocations {
+			labels = append(labels, binLocationLabel(&binLocations[i], warehouse))
+		}
+
+		return http.StatusOK, labels, nil
+	}
+
+	inventories, err := s.inventoryRepository.FindAll(map[string]interface{}{"warehouse_id": warehouse.ID})
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	products := make(map[uint]bool, len(productIDs))
+	for _, productID := range productIDs {
+		products[productID] = true
+	}
+	for i := range inventories {
+		if len(products) > 0 && !products[inventories[i].ProductID] {
+			continue
+		}
+		labels = append(labels, inventoryLabel(&inventories[i]))
+	}
+
+	return http.StatusOK, labels, nil
+}
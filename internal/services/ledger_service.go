@@ -0,0 +1,170 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/pkg/redis"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"gorm.io/gorm"
+
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type LedgerService interface {
+	Check(search_filter map[string]interface{}) (int, *models.LedgerCheckReport, error)
+	Repair(search_filter map[string]interface{}, ctx context.Context) (int, *models.LedgerCheckReport, error)
+}
+
+type ledgerService struct {
+	inventoryRepository   repositories.InventoryRepository
+	transactionRepository repositories.TransactionRepository
+	transactionService    TransactionService
+}
+
+func NewLedgerService(
+	inventoryRepository repositories.InventoryRepository,
+	transactionRepository repositories.TransactionRepository,
+	transactionService TransactionService,
+) LedgerService {
+	return &ledgerService{
+		inventoryRepository:   inventoryRepository,
+		transactionRepository: transactionRepository,
+		transactionService:    transactionService,
+	}
+}
+
+// 재고별로 재고내역 전체를 다시 반영한 수량과 현재 재고 수량 비교 ( warehouse_id, product_id 로 범위 지정 )
+func (s *ledgerService) Check(search_filter map[string]interface{}) (int, *models.LedgerCheckReport, error) {
+	checkedAt := models.GetNowTime()
+
+	inventories, err := s.inventoryRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	inventoryIDs := make([]uint, 0, len(inventories))
+	for _, inventory := range inventories {
+		inventoryIDs = append(inventoryIDs, inventory.ID)
+	}
+
+	transactions, err := s.transactionRepository.FindByInventoryIDsUntil(inventoryIDs, checkedAt)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	ledgers := make(map[uint][]models.Transaction)
+	for _, transaction := range transactions {
+		ledgers[transaction.InventoryID] = append(ledgers[transaction.InventoryID], transaction)
+	}
+
+	report := &models.LedgerCheckReport{
+		CheckedAt:      checkedAt,
+		InventoryCount: len(inventories),
+		Mismatches:     []models.LedgerMismatch{},
+	}
+	for _, inventory := range inventories {
+		ledgerQuantity := models.ReplayQuantity(ledgers[inventory.ID])
+		if ledgerQuantity == inventory.Quantity {
+			continue
+		}
+
+		mismatch := models.LedgerMismatch{
+			InventoryID:    inventory.ID,
+			WarehouseID:    inventory.WarehouseID,
+			ProductID:      inventory.ProductID,
+			Quantity:       inventory.Quantity,
+			LedgerQuantity: ledgerQuantity,
+			Difference:     inventory.Quantity - ledgerQuantity,
+		}
+		if inventory.Warehouse != nil {
+			mismatch.WarehouseName = inventory.Warehouse.Name
+		}
+		if inventory.Product != nil {
+			mismatch.ProductName = inventory.Product.Name
+			mismatch.SKU = inventory.Product.SKU
+		}
+		report.Mismatches = append(report.Mismatches, mismatch)
+	}
+
+	return http.StatusOK, report, nil
+}
+
+// 불일치 재고마다 재고내역 수량으로 조정(ADJUST)하는 재고내역을 사유와 함께 생성
+// - 재고별로 따로 반영하며 실패한 재고는 RepairError 에 사유 기록
+// - 조회 이후 반영된 입출고가 지워지지 않도록 재고 행을 잠근 뒤 재고내역 수량을 다시 계산해서 조정
+func (s *ledgerService) Repair(search_filter map[string]interface{}, ctx context.Context) (int, *models.LedgerCheckReport, error) {
+	status, report, err := s.Check(search_filter)
+	if err != nil {
+		return status, nil, err
+	}
+
+	reference, err := utils.GenerateReference("LEDGER-REPAIR")
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+	report.Repaired = true
+	report.Reference = reference
+
+	var transactions []models.Transaction
+	for i := range report.Mismatches {
+		mismatch := &report.Mismatches[i]
+
+		transaction, err := s.repair(mismatch, reference)
+		if err != nil {
+			mismatch.RepairError = err.Error()
+			continue
+		}
+		if transaction == nil {
+			continue
+		}
+		mismatch.RepairTransactionID = &transaction.ID
+		transactions = append(transactions, *transaction)
+	}
+
+	if len(transactions) > 0 {
+		redis.RestoreRedisData(ctx)
+		s.transactionService.NotifyStockAlerts(transactions)
+	}
+
+	return http.StatusOK, report, nil
+}
+
+// 재고 행을 잠근 상태에서 재고내역 수량을 다시 계산해 조정 재고내역 생성 ( 그 사이 일치하게 된 경우 nil )
+func (s *ledgerService) repair(mismatch *models.LedgerMismatch, reference string) (*models.Transaction, error) {
+	var createdTransaction *models.Transaction
+
+	err := s.transactionService.TransactionWithRetry(func(tx *gorm.DB) error {
+		createdTransaction = nil
+		inventory, err := s.inventoryRepository.WithTx(tx).FindByIDForUpdate(mismatch.InventoryID)
+		if err != nil {
+			return err
+		}
+
+		ledger, err := s.transactionRepository.WithTx(tx).FindByInventoryIDsUntil([]uint{inventory.ID}, models.GetNowTime())
+		if err != nil {
+			return err
+		}
+
+		mismatch.Quantity = inventory.Quantity
+		mismatch.LedgerQuantity = models.ReplayQuantity(ledger)
+		mismatch.Difference = mismatch.Quantity - mismatch.LedgerQuantity
+		if mismatch.Difference == 0 {
+			return nil
+		}
+
+		_, createdTransaction, err = s.transactionService.CreateWithTx(tx, &models.Transaction{
+			InventoryID: inventory.ID,
+			Type:        "ADJUST",
+			Quantity:    mismatch.LedgerQuantity,
+			Timestamp:   models.GetNowTime(),
+			Reference:   reference,
+			Note:        fmt.Sprintf("재고내역 불일치 보정 (재고 수량: %d, 재고내역 수량: %d)", mismatch.Quantity, mismatch.LedgerQuantity),
+		})
+		return err
+	})
+
+	return createdTransaction, err
+}
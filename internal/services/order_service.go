@@ -0,0 +1,232 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/pkg/redis"
+
+	"gorm.io/gorm"
+
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type OrderService interface {
+	FindAll(search_filter map[string]interface{}) (int, []models.Order, error)
+	FindByID(id uint) (int, *models.Order, error)
+	Create(order *models.Order) (int, *models.Order, error)
+	UpdateStatus(id uint, status string, serials map[uint][]string, ctx context.Context) (int, *models.Order, error)
+	Delete(id uint) (int, error)
+}
+
+type orderService struct {
+	orderRepository       repositories.OrderRepository
+	inventoryRepository   repositories.InventoryRepository
+	transactionRepository repositories.TransactionRepository
+	reservationRepository repositories.ReservationRepository
+	transactionService    TransactionService
+}
+
+func NewOrderService(
+	orderRepository repositories.OrderRepository,
+	inventoryRepository repositories.InventoryRepository,
+	transactionRepository repositories.TransactionRepository,
+	reservationRepository repositories.ReservationRepository,
+	transactionService TransactionService,
+) OrderService {
+	return &orderService{
+		orderRepository:       orderRepository,
+		inventoryRepository:   inventoryRepository,
+		transactionRepository: transactionRepository,
+		reservationRepository: reservationRepository,
+		transactionService:    transactionService,
+	}
+}
+
+func (o *orderService) FindAll(search_filter map[string]interface{}) (int, []models.Order, error) {
+	orders, err := o.orderRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, orders, nil
+}
+
+func (o *orderService) FindByID(id uint) (int, *models.Order, error) {
+	order, err := o.orderRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, order, nil
+}
+
+func (o *orderService) Create(order *models.Order) (int, *models.Order, error) {
+	// 주문 항목의 제품이 해당 창고에 재고로 등록되어 있는지 확인
+	for _, item := range order.OrderItems {
+		if _, err := o.inventoryRepository.FindByWarehouseAndProduct(order.WarehouseID, item.ProductID); err != nil {
+			return http.StatusBadRequest, nil, fmt.Errorf("창고에 등록되지 않은 제품입니다 (product_id: %d)", item.ProductID)
+		}
+	}
+
+	order.Status = models.ORDER_STATUS_DRAFT
+
+	createdOrder, err := o.orderRepository.Create(order)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusCreated, createdOrder, nil
+}
+
+// 주문 상태 변경 ( 주문 행을 잠근 상태에서 전환 가능 여부를 확인하고, 출고 완료 전환은 출고 재고내역과 함께 처리 )
+// - serials: 주문 항목 ID -> 출고 일련번호 ( 일련번호 관리 제품 출고 시 필수 )
+func (o *orderService) UpdateStatus(id uint, status string, serials map[uint][]string, ctx context.Context) (int, *models.Order, error) {
+	var transactions []models.Transaction
+	code := http.StatusOK
+
+	err := o.transactionService.TransactionWithRetry(func(tx *gorm.DB) error {
+		orderRepository := o.orderRepository.WithTx(tx)
+
+		order, err := orderRepository.FindByIDForUpdate(id)
+		if err != nil {
+			code = http.StatusInternalServerError
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				code = http.StatusNotFound
+			}
+			return err
+		}
+
+		if !order.CanTransitionTo(status) {
+			code = http.StatusConflict
+			return fmt.Errorf("'%s' 상태의 주문은 '%s' 상태로 변경할 수 없습니다", order.Status, status)
+		}
+
+		switch status {
+		case models.ORDER_STATUS_SHIPPED:
+			code, transactions, err = o.ship(tx, order, serials)
+			if err != nil {
+				return err
+			}
+		case models.ORDER_STATUS_CANCELLED:
+			// 취소된 주문의 예약은 해제
+			if _, err := o.closeReservations(tx, order, models.RESERVATION_STATUS_RELEASED); err != nil {
+				code = http.StatusInternalServerError
+				return err
+			}
+		}
+
+		// 잠금 전에 다른 요청이 상태를 바꾼 경우에도 이전 상태 그대로인 경우에만 변경
+		updated, err := orderRepository.UpdateStatus(id, order.Status, status)
+		if err != nil {
+			code = http.StatusInternalServerError
+			return err
+		}
+		if !updated {
+			code = http.StatusConflict
+			return fmt.Errorf("'%s' 상태의 주문은 '%s' 상태로 변경할 수 없습니다", order.Status, status)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return code, nil, err
+	}
+
+	if status == models.ORDER_STATUS_SHIPPED || status == models.ORDER_STATUS_CANCELLED {
+		redis.RestoreRedisData(ctx)
+		o.transactionService.NotifyStockAlerts(transactions)
+	}
+
+	return o.FindByID(id)
+}
+
+// 주문 항목마다 출고(OUT) 재고내역 생성 ( 주문 상태 변경과 같은 DB 트랜잭션 안에서 실행 )
+// - 주문의 예약은 출고 전에 소진 처리해서 예약 수량만큼 출고할 수 있도록 함
+func (o *orderService) ship(tx *gorm.DB, order *models.Order, serials map[uint][]string) (int, []models.Transaction, error) {
+	var transactions []models.Transaction
+
+	itemIDs := make(map[uint]bool, len(order.OrderItems))
+	for _, item := range order.OrderItems {
+		itemIDs[item.ID] = true
+	}
+	for itemID := range serials {
+		if !itemIDs[itemID] {
+			return http.StatusBadRequest, nil, fmt.Errorf("주문에 포함되지 않은 항목입니다 (item_id: %d)", itemID)
+		}
+	}
+
+	if _, err := o.closeReservations(tx, order, models.RESERVATION_STATUS_CONSUMED); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	for _, item := range order.OrderItems {
+		inventory, err := o.inventoryRepository.WithTx(tx).FindByWarehouseAndProduct(order.WarehouseID, item.ProductID)
+		if err != nil {
+			return http.StatusBadRequest, nil, fmt.Errorf("창고에 등록되지 않은 제품입니다 (product_id: %d)", item.ProductID)
+		}
+
+		transaction := &models.Transaction{
+			InventoryID: inventory.ID,
+			Type:        "OUT",
+			Quantity:    item.Quantity,
+			Timestamp:   models.GetNowTime(),
+			Reference:   order.Reference(),
+			Serials:     serials[item.ID],
+		}
+		code, createdTransaction, err := o.transactionService.CreateWithTx(tx, transaction)
+		if err != nil {
+			return code, nil, err
+		}
+		transactions = append(transactions, *createdTransaction)
+	}
+
+	return http.StatusOK, transactions, nil
+}
+
+// 주문의 활성 예약을 지정 상태로 변경하고 재고의 예약 수량 차감 ( 변경한 예약 개수 반환 )
+func (o *orderService) closeReservations(tx *gorm.DB, order *models.Order, status string) (int, error) {
+	reservationRepository := o.reservationRepository.WithTx(tx)
+
+	reservations, err := reservationRepository.FindActiveByOwnerForUpdate(order.Reference())
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	for _, reservation := range reservations {
+		ok, err := reservationRepository.Release(reservation.ID, status)
+		if err != nil {
+			return closed, err
+		}
+		if !ok {
+			continue
+		}
+
+		if err := o.inventoryRepository.WithTx(tx).UpdateReservedQuantity(reservation.InventoryID, -reservation.Quantity); err != nil {
+			return closed, err
+		}
+		closed++
+	}
+
+	return closed, nil
+}
+
+func (o *orderService) Delete(id uint) (int, error) {
+	order, err := o.orderRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if order.Status != models.ORDER_STATUS_DRAFT && order.Status != models.ORDER_STATUS_CANCELLED {
+		return http.StatusConflict, errors.New("작성 중이거나 취소된 주문만 삭제할 수 있습니다")
+	}
+
+	if err := o.orderRepository.Delete(id); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
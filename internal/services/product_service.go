@@ -5,24 +5,34 @@ import (
 	"github.com/jhphon0730/StockFlow/internal/repositories"
 	"github.com/jhphon0730/StockFlow/pkg/redis"
 
+	"gorm.io/gorm"
+
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 )
 
 type ProductService interface {
-	FindAll(ctx context.Context, search_filter map[string]interface{}) (int, []models.Product, error)
+	FindAll(ctx context.Context, search_filter map[string]interface{}, page models.PageQuery) (int, []models.Product, *models.Pagination, error)
 	FindByID(id uint) (int, *models.Product, error)
 	Create(product *models.Product, ctx context.Context) (int, *models.Product, error)
+	Update(id uint, updates map[string]interface{}, ctx context.Context) (int, *models.Product, error)
 	Delete(id uint, ctx context.Context) (int, error)
+	UpdateUnits(id uint, units []models.ProductUnit, ctx context.Context) (int, *models.Product, error)
+	UpdateAttributes(id uint, attributes []models.ProductAttribute, ctx context.Context) (int, *models.Product, error)
 }
 
 type productService struct {
-	productRepository repositories.ProductRepository
+	productRepository  repositories.ProductRepository
+	categoryRepository repositories.CategoryRepository
 }
 
-func NewProductService(productRepository repositories.ProductRepository) ProductService {
+func NewProductService(productRepository repositories.ProductRepository, categoryRepository repositories.CategoryRepository) ProductService {
 	return &productService{
-		productRepository: productRepository,
+		productRepository:  productRepository,
+		categoryRepository: categoryRepository,
 	}
 }
 
@@ -35,26 +45,48 @@ func (p *productService) getProductRedis(ctx context.Context) redis.ProductRedis
 	return productRedis
 }
 
-func (p *productService) FindAll(ctx context.Context, search_filter map[string]interface{}) (int, []models.Product, error) {
+// 검색 조건이 없는 목록은 페이지 조건별로 캐시
+func (p *productService) FindAll(ctx context.Context, search_filter map[string]interface{}, page models.PageQuery) (int, []models.Product, *models.Pagination, error) {
 	productRedis := p.getProductRedis(ctx)
+	cacheable := productRedis != nil && len(search_filter) == 0
+
+	if cacheable {
+		products, pagination, err := productRedis.GetProductCache(ctx, page)
+		if err == nil && pagination != nil {
+			return http.StatusOK, products, pagination, nil
+		}
+	}
+
+	// 분류 조건은 하위 분류까지 포함
+	if categoryID, ok := search_filter["category_id"]; ok {
+		id, err := strconv.ParseUint(categoryID.(string), 10, 64)
+		if err != nil {
+			return http.StatusBadRequest, nil, nil, errors.New("분류 ID가 올바르지 않습니다")
+		}
 
-	if productRedis != nil && len(search_filter) == 0 {
-		products, err := productRedis.GetProductCache(ctx)
-		if err == nil && len(products) > 0 {
-			return http.StatusOK, products, nil
+		categories, err := p.categoryRepository.FindAll(map[string]interface{}{})
+		if err != nil {
+			return http.StatusInternalServerError, nil, nil, err
 		}
+
+		delete(search_filter, "category_id")
+		search_filter["category_ids"] = collectCategoryIDs(categories, uint(id))
 	}
 
-	products, err := p.productRepository.FindAll(search_filter)
+	products, pagination, err := p.productRepository.FindPage(search_filter, page)
 	if err != nil {
-		return http.StatusInternalServerError, nil, err
+		return http.StatusInternalServerError, nil, nil, err
+	}
+
+	for i := range products {
+		products[i].RollUpStock()
 	}
 
-	if productRedis != nil && len(search_filter) == 0 {
-		_ = productRedis.SetProductCache(ctx, products)
+	if cacheable {
+		_ = productRedis.SetProductCache(ctx, page, products, pagination)
 	}
 
-	return http.StatusOK, products, nil
+	return http.StatusOK, products, pagination, nil
 }
 
 func (p *productService) FindByID(id uint) (int, *models.Product, error) {
@@ -62,11 +94,30 @@ func (p *productService) FindByID(id uint) (int, *models.Product, error) {
 	if err != nil {
 		return http.StatusInternalServerError, nil, err
 	}
+	product.RollUpStock()
 
 	return http.StatusOK, product, nil
 }
 
 func (p *productService) Create(product *models.Product, ctx context.Context) (int, *models.Product, error) {
+	if product.CategoryID != nil {
+		if _, err := p.categoryRepository.FindByID(*product.CategoryID); err != nil {
+			return http.StatusBadRequest, nil, errors.New("존재하지 않는 분류입니다")
+		}
+	}
+
+	// 변형 제품은 한 단계만 허용
+	if product.ParentID != nil {
+		parent, err := p.productRepository.FindByID(*product.ParentID)
+		if err != nil {
+			return http.StatusBadRequest, nil, errors.New("존재하지 않는 상위 제품입니다")
+		}
+
+		if parent.ParentID != nil {
+			return http.StatusBadRequest, nil, errors.New("변형 제품을 상위 제품으로 지정할 수 없습니다")
+		}
+	}
+
 	createdProduct, err := p.productRepository.Create(product)
 	if err != nil {
 		return http.StatusInternalServerError, nil, err
@@ -77,8 +128,73 @@ func (p *productService) Create(product *models.Product, ctx context.Context) (i
 	return http.StatusCreated, createdProduct, nil
 }
 
+// 제품 정보 변경 ( 재고와 재고내역은 그대로 유지 )
+func (p *productService) Update(id uint, updates map[string]interface{}, ctx context.Context) (int, *models.Product, error) {
+	product, err := p.productRepository.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusNotFound, nil, errors.New("존재하지 않는 제품입니다")
+		}
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if sku, ok := updates["sku"].(string); ok {
+		exists, err := p.productRepository.ExistsSKU(sku, id)
+		if err != nil {
+			return http.StatusInternalServerError, nil, err
+		}
+		if exists {
+			return http.StatusConflict, nil, errors.New("이미 사용 중인 SKU입니다")
+		}
+	}
+
+	if categoryID, ok := updates["category_id"].(*uint); ok && categoryID != nil {
+		if _, err := p.categoryRepository.FindByID(*categoryID); err != nil {
+			return http.StatusBadRequest, nil, errors.New("존재하지 않는 분류입니다")
+		}
+	}
+
+	// 변형 제품은 한 단계만 허용
+	if parentID, ok := updates["parent_id"].(*uint); ok && parentID != nil {
+		if *parentID == id {
+			return http.StatusBadRequest, nil, errors.New("자기 자신을 상위 제품으로 지정할 수 없습니다")
+		}
+
+		parent, err := p.productRepository.FindByID(*parentID)
+		if err != nil {
+			return http.StatusBadRequest, nil, errors.New("존재하지 않는 상위 제품입니다")
+		}
+
+		if parent.ParentID != nil {
+			return http.StatusBadRequest, nil, errors.New("변형 제품을 상위 제품으로 지정할 수 없습니다")
+		}
+
+		if len(product.Variants) > 0 {
+			return http.StatusBadRequest, nil, errors.New("변형 제품이 있는 제품은 다른 제품의 변형 제품이 될 수 없습니다")
+		}
+	}
+
+	if err := p.productRepository.Update(id, updates); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+
+	return p.FindByID(id)
+}
+
+// 변형 제품이 있는 상위 제품은 삭제 불가
 func (p *productService) Delete(id uint, ctx context.Context) (int, error) {
-	err := p.productRepository.Delete(id)
+	variants, err := p.productRepository.FindAll(map[string]interface{}{"parent_id": id})
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if len(variants) > 0 {
+		return http.StatusConflict, errors.New("변형 제품이 있는 제품은 삭제할 수 없습니다")
+	}
+
+	err = p.productRepository.Delete(id)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -87,3 +203,40 @@ func (p *productService) Delete(id uint, ctx context.Context) (int, error) {
 
 	return http.StatusOK, nil
 }
+
+// 제품의 환산 단위 교체 ( 기존 재고내역은 기본 단위로 저장되어 있어 영향 없음 )
+func (p *productService) UpdateUnits(id uint, units []models.ProductUnit, ctx context.Context) (int, *models.Product, error) {
+	product, err := p.productRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	for _, unit := range units {
+		if unit.Name == product.BaseUnit {
+			return http.StatusBadRequest, nil, fmt.Errorf("기본 단위는 환산 단위로 등록할 수 없습니다 (%s)", unit.Name)
+		}
+	}
+
+	if err := p.productRepository.ReplaceUnits(id, units); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+
+	return p.FindByID(id)
+}
+
+// 제품의 사용자 정의 속성 교체
+func (p *productService) UpdateAttributes(id uint, attributes []models.ProductAttribute, ctx context.Context) (int, *models.Product, error) {
+	if _, err := p.productRepository.FindByID(id); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if err := p.productRepository.ReplaceAttributes(id, attributes); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+
+	return p.FindByID(id)
+}
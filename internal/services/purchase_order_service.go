@@ -0,0 +1,261 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/pkg/redis"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"gorm.io/gorm"
+
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type PurchaseOrderService interface {
+	FindAll(search_filter map[string]interface{}) (int, []models.PurchaseOrder, error)
+	FindByID(id uint) (int, *models.PurchaseOrder, error)
+	Create(purchaseOrder *models.PurchaseOrder) (int, *models.PurchaseOrder, error)
+	Update(id uint, purchaseOrder *models.PurchaseOrder) (int, *models.PurchaseOrder, error)
+	Order(id uint) (int, *models.PurchaseOrder, error)
+	Receive(id uint, receipts map[uint]models.PurchaseOrderReceipt, ctx context.Context) (int, *models.PurchaseOrder, error)
+	Cancel(id uint) (int, *models.PurchaseOrder, error)
+	Delete(id uint) (int, error)
+}
+
+type purchaseOrderService struct {
+	purchaseOrderRepository repositories.PurchaseOrderRepository
+	supplierRepository      repositories.SupplierRepository
+	inventoryRepository     repositories.InventoryRepository
+	transactionRepository   repositories.TransactionRepository
+	transactionService      TransactionService
+}
+
+func NewPurchaseOrderService(
+	purchaseOrderRepository repositories.PurchaseOrderRepository,
+	supplierRepository repositories.SupplierRepository,
+	inventoryRepository repositories.InventoryRepository,
+	transactionRepository repositories.TransactionRepository,
+	transactionService TransactionService,
+) PurchaseOrderService {
+	return &purchaseOrderService{
+		purchaseOrderRepository: purchaseOrderRepository,
+		supplierRepository:      supplierRepository,
+		inventoryRepository:     inventoryRepository,
+		transactionRepository:   transactionRepository,
+		transactionService:      transactionService,
+	}
+}
+
+func (p *purchaseOrderService) FindAll(search_filter map[string]interface{}) (int, []models.PurchaseOrder, error) {
+	purchaseOrders, err := p.purchaseOrderRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, purchaseOrders, nil
+}
+
+func (p *purchaseOrderService) FindByID(id uint) (int, *models.PurchaseOrder, error) {
+	purchaseOrder, err := p.purchaseOrderRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, purchaseOrder, nil
+}
+
+func (p *purchaseOrderService) Create(purchaseOrder *models.PurchaseOrder) (int, *models.PurchaseOrder, error) {
+	if _, err := p.supplierRepository.FindByID(purchaseOrder.SupplierID); err != nil {
+		return http.StatusBadRequest, nil, errors.New("존재하지 않는 공급업체입니다")
+	}
+
+	reference, err := utils.GenerateReference("PO")
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	purchaseOrder.Status = models.PURCHASE_STATUS_DRAFT
+	purchaseOrder.Reference = reference
+
+	createdPurchaseOrder, err := p.purchaseOrderRepository.Create(purchaseOrder)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusCreated, createdPurchaseOrder, nil
+}
+
+// 작성 중인 발주만 수정 가능 ( 항목 전체 교체 )
+func (p *purchaseOrderService) Update(id uint, purchaseOrder *models.PurchaseOrder) (int, *models.PurchaseOrder, error) {
+	existing, err := p.purchaseOrderRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if existing.Status != models.PURCHASE_STATUS_DRAFT {
+		return http.StatusConflict, nil, errors.New("작성 중인 발주만 수정할 수 있습니다")
+	}
+
+	if _, err := p.supplierRepository.FindByID(purchaseOrder.SupplierID); err != nil {
+		return http.StatusBadRequest, nil, errors.New("존재하지 않는 공급업체입니다")
+	}
+
+	purchaseOrder.ID = id
+	if err := p.purchaseOrderRepository.Update(purchaseOrder); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return p.FindByID(id)
+}
+
+// 작성 중인 발주를 공급업체에 발주 ( 이후 입고 가능 )
+func (p *purchaseOrderService) Order(id uint) (int, *models.PurchaseOrder, error) {
+	purchaseOrder, err := p.purchaseOrderRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if purchaseOrder.Status != models.PURCHASE_STATUS_DRAFT {
+		return http.StatusConflict, nil, errors.New("작성 중인 발주만 발주할 수 있습니다")
+	}
+
+	if err := p.purchaseOrderRepository.UpdateStatus(id, models.PURCHASE_STATUS_ORDERED); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return p.FindByID(id)
+}
+
+// 입고 창고에 항목별 수량을 입고(IN) ( 전체 수량이 입고되면 입고 완료 상태로 변경 )
+func (p *purchaseOrderService) Receive(id uint, receipts map[uint]models.PurchaseOrderReceipt, ctx context.Context) (int, *models.PurchaseOrder, error) {
+	purchaseOrder, err := p.purchaseOrderRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if purchaseOrder.Status != models.PURCHASE_STATUS_ORDERED && purchaseOrder.Status != models.PURCHASE_STATUS_PARTIALLY_RECEIVED {
+		return http.StatusConflict, nil, errors.New("발주된 발주만 입고할 수 있습니다")
+	}
+
+	lines := make(map[uint]models.PurchaseOrderLine)
+	for _, line := range purchaseOrder.Lines {
+		lines[line.ID] = line
+	}
+
+	for lineID, receipt := range receipts {
+		line, ok := lines[lineID]
+		if !ok {
+			return http.StatusBadRequest, nil, fmt.Errorf("발주에 포함되지 않은 항목입니다 (line_id: %d)", lineID)
+		}
+
+		if receipt.Quantity > line.RemainingQuantity() {
+			return http.StatusBadRequest, nil, fmt.Errorf("입고 수량이 미입고 수량보다 많습니다 (line_id: %d)", lineID)
+		}
+	}
+
+	var transactions []models.Transaction
+	status := http.StatusOK
+	err = p.transactionService.TransactionWithRetry(func(tx *gorm.DB) error {
+		transactions = nil
+		purchaseOrderRepository := p.purchaseOrderRepository.WithTx(tx)
+
+		outstanding := 0
+		for _, line := range purchaseOrder.Lines {
+			receipt := receipts[line.ID]
+			quantity := receipt.Quantity
+			outstanding += line.RemainingQuantity() - quantity
+			if quantity == 0 {
+				continue
+			}
+
+			inventory, err := p.inventoryRepository.WithTx(tx).FindOrCreate(purchaseOrder.WarehouseID, line.ProductID)
+			if err != nil {
+				status = http.StatusInternalServerError
+				return err
+			}
+
+			transaction := &models.Transaction{
+				InventoryID:    inventory.ID,
+				Type:           "IN",
+				Quantity:       quantity,
+				Timestamp:      models.GetNowTime(),
+				Reference:      purchaseOrder.Reference,
+				LotNumber:      receipt.LotNumber,
+				ManufacturedAt: receipt.ManufacturedAt,
+				ExpiresAt:      receipt.ExpiresAt,
+				Serials:        receipt.Serials,
+				BinLocationID:  receipt.BinLocationID,
+				UnitCost:       line.UnitCost,
+			}
+			code, createdTransaction, err := p.transactionService.CreateWithTx(tx, transaction)
+			if err != nil {
+				status = code
+				return err
+			}
+			transactions = append(transactions, *createdTransaction)
+
+			if err := purchaseOrderRepository.AddReceivedQuantity(line.ID, quantity); err != nil {
+				status = http.StatusInternalServerError
+				return err
+			}
+		}
+
+		next := models.PURCHASE_STATUS_PARTIALLY_RECEIVED
+		if outstanding == 0 {
+			next = models.PURCHASE_STATUS_RECEIVED
+		}
+
+		if err := purchaseOrderRepository.UpdateStatus(id, next); err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+	p.transactionService.NotifyStockAlerts(transactions)
+
+	return p.FindByID(id)
+}
+
+// 입고 전 발주 취소
+func (p *purchaseOrderService) Cancel(id uint) (int, *models.PurchaseOrder, error) {
+	purchaseOrder, err := p.purchaseOrderRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if purchaseOrder.Status != models.PURCHASE_STATUS_DRAFT && purchaseOrder.Status != models.PURCHASE_STATUS_ORDERED {
+		return http.StatusConflict, nil, errors.New("입고 전인 발주만 취소할 수 있습니다")
+	}
+
+	if err := p.purchaseOrderRepository.UpdateStatus(id, models.PURCHASE_STATUS_CANCELLED); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return p.FindByID(id)
+}
+
+func (p *purchaseOrderService) Delete(id uint) (int, error) {
+	purchaseOrder, err := p.purchaseOrderRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if purchaseOrder.Status != models.PURCHASE_STATUS_DRAFT && purchaseOrder.Status != models.PURCHASE_STATUS_CANCELLED {
+		return http.StatusConflict, errors.New("작성 중이거나 취소된 발주만 삭제할 수 있습니다")
+	}
+
+	if err := p.purchaseOrderRepository.Delete(id); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/pkg/redis"
+
+	"gorm.io/gorm"
+
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+)
+
+type ReservationService interface {
+	FindAll(search_filter map[string]interface{}) (int, []models.Reservation, error)
+	FindByID(id uint) (int, *models.Reservation, error)
+	Create(reservation *models.Reservation, ctx context.Context) (int, *models.Reservation, error)
+	Release(id uint, ctx context.Context) (int, *models.Reservation, error)
+	ReleaseExpired(ctx context.Context) (int, int, error)
+	StartExpirySweeper(ctx context.Context, interval time.Duration)
+}
+
+type reservationService struct {
+	reservationRepository repositories.ReservationRepository
+	inventoryRepository   repositories.InventoryRepository
+}
+
+func NewReservationService(reservationRepository repositories.ReservationRepository, inventoryRepository repositories.InventoryRepository) ReservationService {
+	return &reservationService{
+		reservationRepository: reservationRepository,
+		inventoryRepository:   inventoryRepository,
+	}
+}
+
+func (r *reservationService) FindAll(search_filter map[string]interface{}) (int, []models.Reservation, error) {
+	reservations, err := r.reservationRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, reservations, nil
+}
+
+func (r *reservationService) FindByID(id uint) (int, *models.Reservation, error) {
+	reservation, err := r.reservationRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, reservation, nil
+}
+
+// 가용 수량 범위 안에서 예약 생성 ( 재고 행 잠금 후 확인 )
+func (r *reservationService) Create(reservation *models.Reservation, ctx context.Context) (int, *models.Reservation, error) {
+	var createdReservation *models.Reservation
+	status := http.StatusCreated
+
+	err := r.reservationRepository.Transaction(func(tx *gorm.DB) error {
+		inventoryRepository := r.inventoryRepository.WithTx(tx)
+
+		inventory, err := inventoryRepository.FindByIDForUpdate(reservation.InventoryID)
+		if err != nil {
+			status = http.StatusBadRequest
+			return errors.New("존재하지 않는 재고입니다")
+		}
+
+		if inventory.AvailableQuantity < reservation.Quantity {
+			status = http.StatusConflict
+			return errors.New("가용 수량이 부족합니다")
+		}
+
+		reservation.Status = models.RESERVATION_STATUS_ACTIVE
+		createdReservation, err = r.reservationRepository.WithTx(tx).Create(reservation)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		if err := inventoryRepository.UpdateReservedQuantity(inventory.ID, reservation.Quantity); err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+
+	return http.StatusCreated, createdReservation, nil
+}
+
+func (r *reservationService) Release(id uint, ctx context.Context) (int, *models.Reservation, error) {
+	reservation, err := r.reservationRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if reservation.Status != models.RESERVATION_STATUS_ACTIVE {
+		return http.StatusConflict, nil, errors.New("이미 해제된 예약입니다")
+	}
+
+	released, err := r.release(reservation, models.RESERVATION_STATUS_RELEASED)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if !released {
+		return http.StatusConflict, nil, errors.New("이미 해제된 예약입니다")
+	}
+
+	redis.RestoreRedisData(ctx)
+
+	return r.FindByID(id)
+}
+
+// 만료된 예약을 모두 해제하고 해제된 개수를 반환
+func (r *reservationService) ReleaseExpired(ctx context.Context) (int, int, error) {
+	reservations, err := r.reservationRepository.FindExpired(models.GetNowTime())
+	if err != nil {
+		return http.StatusInternalServerError, 0, err
+	}
+
+	released := 0
+	for i := range reservations {
+		ok, err := r.release(&reservations[i], models.RESERVATION_STATUS_EXPIRED)
+		if err != nil {
+			return http.StatusInternalServerError, released, err
+		}
+
+		if ok {
+			released++
+		}
+	}
+
+	if released > 0 {
+		redis.RestoreRedisData(ctx)
+	}
+
+	return http.StatusOK, released, nil
+}
+
+// interval 마다 만료된 예약을 해제 ( ctx 가 취소되면 종료 )
+func (r *reservationService) StartExpirySweeper(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, released, err := r.ReleaseExpired(ctx); err != nil {
+					log.Println("Reservation sweeper error:", err)
+				} else if released > 0 {
+					log.Printf("Reservation sweeper released %d expired reservations\n", released)
+				}
+			}
+		}
+	}()
+}
+
+// 예약 상태 변경과 예약 수량 차감을 하나의 DB 트랜잭션으로 처리 ( 다른 요청이 먼저 해제한 경우 false )
+func (r *reservationService) release(reservation *models.Reservation, status string) (bool, error) {
+	released := false
+
+	err := r.reservationRepository.Transaction(func(tx *gorm.DB) error {
+		ok, err := r.reservationRepository.WithTx(tx).Release(reservation.ID, status)
+		if err != nil || !ok {
+			return err
+		}
+
+		released = true
+		return r.inventoryRepository.WithTx(tx).UpdateReservedQuantity(reservation.InventoryID, -reservation.Quantity)
+	})
+
+	return released, err
+}
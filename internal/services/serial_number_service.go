@@ -0,0 +1,38 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+
+	"gorm.io/gorm"
+
+	"errors"
+	"net/http"
+)
+
+type SerialNumberService interface {
+	FindBySerial(serial string) (int, *models.SerialNumber, error)
+}
+
+type serialNumberService struct {
+	serialNumberRepository repositories.SerialNumberRepository
+}
+
+func NewSerialNumberService(serialNumberRepository repositories.SerialNumberRepository) SerialNumberService {
+	return &serialNumberService{
+		serialNumberRepository: serialNumberRepository,
+	}
+}
+
+// 일련번호의 현재 위치와 전체 이동 이력 조회
+func (s *serialNumberService) FindBySerial(serial string) (int, *models.SerialNumber, error) {
+	serialNumber, err := s.serialNumberRepository.FindBySerial(serial)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusNotFound, nil, errors.New("존재하지 않는 일련번호입니다")
+		}
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, serialNumber, nil
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+
+	"net/http"
+)
+
+type StockAlertService interface {
+	FindAll(search_filter map[string]interface{}) (int, []models.StockAlert, error)
+	Acknowledge(id uint) (int, *models.StockAlert, error)
+}
+
+type stockAlertService struct {
+	stockAlertRepository repositories.StockAlertRepository
+}
+
+func NewStockAlertService(stockAlertRepository repositories.StockAlertRepository) StockAlertService {
+	return &stockAlertService{
+		stockAlertRepository: stockAlertRepository,
+	}
+}
+
+func (s *stockAlertService) FindAll(search_filter map[string]interface{}) (int, []models.StockAlert, error) {
+	stockAlerts, err := s.stockAlertRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, stockAlerts, nil
+}
+
+// 알림 확인 처리
+func (s *stockAlertService) Acknowledge(id uint) (int, *models.StockAlert, error) {
+	if _, err := s.stockAlertRepository.FindByID(id); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if err := s.stockAlertRepository.Acknowledge(id); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	stockAlert, err := s.stockAlertRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, stockAlert, nil
+}
@@ -0,0 +1,377 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/pkg/redis"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"gorm.io/gorm"
+
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type StocktakeService interface {
+	FindAll(search_filter map[string]interface{}) (int, []models.Stocktake, error)
+	FindByID(id uint) (int, *models.Stocktake, error)
+	Create(stocktake *models.Stocktake) (int, *models.Stocktake, error)
+	SubmitCounts(id uint, counts map[uint]int) (int, *models.Stocktake, error)
+	GetVarianceReport(id uint) (int, *models.StocktakeVarianceReport, error)
+	Approve(id uint, userID uint, ctx context.Context) (int, *models.Stocktake, error)
+	Cancel(id uint) (int, *models.Stocktake, error)
+}
+
+type stocktakeService struct {
+	stocktakeRepository   repositories.StocktakeRepository
+	warehouseRepository   repositories.WarehouseRepository
+	inventoryRepository   repositories.InventoryRepository
+	binLocationRepository repositories.BinLocationRepository
+	transactionRepository repositories.TransactionRepository
+	transactionService    TransactionService
+}
+
+func NewStocktakeService(
+	stocktakeRepository repositories.StocktakeRepository,
+	warehouseRepository repositories.WarehouseRepository,
+	inventoryRepository repositories.InventoryRepository,
+	binLocationRepository repositories.BinLocationRepository,
+	transactionRepository repositories.TransactionRepository,
+	transactionService TransactionService,
+) StocktakeService {
+	return &stocktakeService{
+		stocktakeRepository:   stocktakeRepository,
+		warehouseRepository:   warehouseRepository,
+		inventoryRepository:   inventoryRepository,
+		binLocationRepository: binLocationRepository,
+		transactionRepository: transactionRepository,
+		transactionService:    transactionService,
+	}
+}
+
+func (s *stocktakeService) FindAll(search_filter map[string]interface{}) (int, []models.Stocktake, error) {
+	stocktakes, err := s.stocktakeRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, stocktakes, nil
+}
+
+func (s *stocktakeService) FindByID(id uint) (int, *models.Stocktake, error) {
+	stocktake, err := s.stocktakeRepository.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusNotFound, nil, errors.New("존재하지 않는 실사입니다")
+		}
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, stocktake, nil
+}
+
+// 실사 시작 ( 범위 안의 재고마다 현재 수량을 예상 수량으로 고정한 항목 생성 )
+func (s *stocktakeService) Create(stocktake *models.Stocktake) (int, *models.Stocktake, error) {
+	if _, err := s.warehouseRepository.FindByID(stocktake.WarehouseID); err != nil {
+		return http.StatusBadRequest, nil, errors.New("존재하지 않는 창고입니다")
+	}
+
+	lines, err := s.buildLines(stocktake)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if len(lines) == 0 {
+		return http.StatusBadRequest, nil, errors.New("실사 범위에 해당하는 재고가 없습니다")
+	}
+
+	reference, err := utils.GenerateReference("STOCKTAKE")
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	stocktake.Status = models.STOCKTAKE_STATUS_OPEN
+	stocktake.Reference = reference
+	stocktake.Lines = lines
+
+	createdStocktake, err := s.stocktakeRepository.Create(stocktake)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusCreated, createdStocktake, nil
+}
+
+// 창고 전체는 재고별, 보관 위치 범위는 위치-재고별로 항목 생성
+func (s *stocktakeService) buildLines(stocktake *models.Stocktake) ([]models.StocktakeLine, error) {
+	var lines []models.StocktakeLine
+
+	if stocktake.BinCodePrefix == "" {
+		inventories, err := s.inventoryRepository.FindAll(map[string]interface{}{"warehouse_id": stocktake.WarehouseID})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, inventory := range inventories {
+			lines = append(lines, models.StocktakeLine{
+				InventoryID:      inventory.ID,
+				ExpectedQuantity: inventory.Quantity,
+			})
+		}
+
+		return lines, nil
+	}
+
+	binLocations, err := s.binLocationRepository.FindAll(map[string]interface{}{
+		"warehouse_id": stocktake.WarehouseID,
+		"code_prefix":  stocktake.BinCodePrefix,
+	})
+	if err != nil || len(binLocations) == 0 {
+		return nil, err
+	}
+
+	binLocationIDs := make([]uint, 0, len(binLocations))
+	for _, binLocation := range binLocations {
+		binLocationIDs = append(binLocationIDs, binLocation.ID)
+	}
+
+	binStocks, err := s.binLocationRepository.FindStocksByBinLocationIDs(binLocationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, binStock := range binStocks {
+		binLocationID := binStock.BinLocationID
+		lines = append(lines, models.StocktakeLine{
+			InventoryID:      binStock.InventoryID,
+			BinLocationID:    &binLocationID,
+			ExpectedQuantity: binStock.Quantity,
+		})
+	}
+
+	return lines, nil
+}
+
+// 실사 항목별 실사 수량 입력 ( 항목 ID -> 실사 수량 )
+// - 진행 중인 실사의 항목만 변경해서 승인/취소와 동시에 요청되어도 승인된 실사의 수량이 바뀌지 않도록 처리
+func (s *stocktakeService) SubmitCounts(id uint, counts map[uint]int) (int, *models.Stocktake, error) {
+	stocktake, err := s.stocktakeRepository.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusNotFound, nil, errors.New("존재하지 않는 실사입니다")
+		}
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if stocktake.Status != models.STOCKTAKE_STATUS_OPEN {
+		return http.StatusConflict, nil, errors.New("진행 중인 실사만 실사 수량을 입력할 수 있습니다")
+	}
+
+	lines := make(map[uint]bool, len(stocktake.Lines))
+	for _, line := range stocktake.Lines {
+		lines[line.ID] = true
+	}
+
+	for lineID := range counts {
+		if !lines[lineID] {
+			return http.StatusBadRequest, nil, fmt.Errorf("실사에 포함되지 않은 항목입니다 (line_id: %d)", lineID)
+		}
+	}
+
+	status := http.StatusOK
+	err = s.transactionRepository.Transaction(func(tx *gorm.DB) error {
+		stocktakeRepository := s.stocktakeRepository.WithTx(tx)
+
+		// 승인과 같은 실사 행 잠금으로 순서를 정해 승인 중인 실사의 항목을 읽은 뒤 수량이 바뀌지 않도록 처리
+		if _, err := stocktakeRepository.FindByIDForUpdate(id); err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		for lineID, countedQuantity := range counts {
+			updated, err := stocktakeRepository.UpdateCountedQuantity(id, lineID, countedQuantity)
+			if err != nil {
+				status = http.StatusInternalServerError
+				return err
+			}
+			if !updated {
+				status = http.StatusConflict
+				return errors.New("진행 중인 실사만 실사 수량을 입력할 수 있습니다")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	return s.FindByID(id)
+}
+
+// 실사 차이 보고서 ( 차이가 있는 항목만 포함 )
+func (s *stocktakeService) GetVarianceReport(id uint) (int, *models.StocktakeVarianceReport, error) {
+	stocktake, err := s.stocktakeRepository.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusNotFound, nil, errors.New("존재하지 않는 실사입니다")
+		}
+		return http.StatusInternalServerError, nil, err
+	}
+
+	report := &models.StocktakeVarianceReport{
+		StocktakeID: stocktake.ID,
+		Reference:   stocktake.Reference,
+		Status:      stocktake.Status,
+		TotalLines:  len(stocktake.Lines),
+		Lines:       []models.StocktakeLine{},
+	}
+
+	for _, line := range stocktake.Lines {
+		if line.CountedQuantity == nil {
+			continue
+		}
+
+		report.CountedLines++
+		if line.Variance != 0 {
+			report.DiscrepancyLines++
+			report.NetVariance += line.Variance
+			report.Lines = append(report.Lines, line)
+		}
+	}
+
+	return http.StatusOK, report, nil
+}
+
+// 실사 승인 ( 차이가 있는 항목마다 조정(ADJUST) 재고내역 생성 )
+// - 실사 행을 잠근 상태에서 상태를 다시 확인해 동시 승인 시 차이 수량이 두 번 반영되지 않도록 처리
+func (s *stocktakeService) Approve(id uint, userID uint, ctx context.Context) (int, *models.Stocktake, error) {
+	var transactions []models.Transaction
+	status := http.StatusOK
+	err := s.transactionService.TransactionWithRetry(func(tx *gorm.DB) error {
+		transactions = nil
+		stocktakeRepository := s.stocktakeRepository.WithTx(tx)
+
+		stocktake, err := stocktakeRepository.FindByIDForUpdate(id)
+		if err != nil {
+			status = http.StatusInternalServerError
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				status = http.StatusNotFound
+			}
+			return err
+		}
+
+		if stocktake.Status != models.STOCKTAKE_STATUS_OPEN {
+			status = http.StatusConflict
+			return errors.New("진행 중인 실사만 승인할 수 있습니다")
+		}
+
+		for _, line := range stocktake.Lines {
+			if line.CountedQuantity == nil {
+				status = http.StatusConflict
+				return fmt.Errorf("실사 수량이 입력되지 않은 항목이 있습니다 (line_id: %d)", line.ID)
+			}
+		}
+
+		for _, line := range stocktake.Lines {
+			if line.Variance == 0 {
+				continue
+			}
+
+			// 실사 중 발생한 입출고를 유지하도록 현재 수량에 차이 수량만 반영
+			inventory, err := s.inventoryRepository.WithTx(tx).FindByIDForUpdate(line.InventoryID)
+			if err != nil {
+				status = http.StatusBadRequest
+				return fmt.Errorf("존재하지 않는 재고입니다 (inventory_id: %d)", line.InventoryID)
+			}
+
+			transaction := &models.Transaction{
+				InventoryID: inventory.ID,
+				Type:        "ADJUST",
+				Quantity:    inventory.Quantity + line.Variance,
+				Timestamp:   models.GetNowTime(),
+				Reference:   stocktake.Reference,
+			}
+			code, createdTransaction, err := s.transactionService.CreateWithTx(tx, transaction)
+			if err != nil {
+				status = code
+				return err
+			}
+			transactions = append(transactions, *createdTransaction)
+
+			if line.BinLocationID != nil {
+				if code, err := s.adjustBinStock(tx, line); err != nil {
+					status = code
+					return err
+				}
+			}
+		}
+
+		approved, err := stocktakeRepository.Approve(id, userID)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		if !approved {
+			status = http.StatusConflict
+			return errors.New("진행 중인 실사만 승인할 수 있습니다")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+	s.transactionService.NotifyStockAlerts(transactions)
+
+	return s.FindByID(id)
+}
+
+// 보관 위치 범위 실사의 위치별 수량에 차이 수량 반영
+func (s *stocktakeService) adjustBinStock(tx *gorm.DB, line models.StocktakeLine) (int, error) {
+	binLocationRepository := s.binLocationRepository.WithTx(tx)
+
+	binStock, err := binLocationRepository.FindStockForUpdate(line.InventoryID, *line.BinLocationID)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if binStock.Quantity+line.Variance < 0 {
+		return http.StatusConflict, fmt.Errorf("보관 위치 재고가 음수가 됩니다 (line_id: %d)", line.ID)
+	}
+
+	if err := binLocationRepository.AddStockQuantity(line.InventoryID, *line.BinLocationID, line.Variance); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
+
+// 승인 전 실사 취소 ( 재고 변경 없음, 동시에 승인된 실사는 취소하지 않음 )
+func (s *stocktakeService) Cancel(id uint) (int, *models.Stocktake, error) {
+	stocktake, err := s.stocktakeRepository.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusNotFound, nil, errors.New("존재하지 않는 실사입니다")
+		}
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if stocktake.Status != models.STOCKTAKE_STATUS_OPEN {
+		return http.StatusConflict, nil, errors.New("진행 중인 실사만 취소할 수 있습니다")
+	}
+
+	cancelled, err := s.stocktakeRepository.UpdateStatus(id, models.STOCKTAKE_STATUS_OPEN, models.STOCKTAKE_STATUS_CANCELLED)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+	if !cancelled {
+		return http.StatusConflict, nil, errors.New("진행 중인 실사만 취소할 수 있습니다")
+	}
+
+	return s.FindByID(id)
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+
+	"errors"
+	"net/http"
+)
+
+type SupplierService interface {
+	FindAll(search_filter map[string]interface{}) (int, []models.Supplier, error)
+	FindByID(id uint) (int, *models.Supplier, error)
+	Create(supplier *models.Supplier) (int, *models.Supplier, error)
+	Update(id uint, supplier *models.Supplier) (int, *models.Supplier, error)
+	Delete(id uint) (int, error)
+}
+
+type supplierService struct {
+	supplierRepository      repositories.SupplierRepository
+	purchaseOrderRepository repositories.PurchaseOrderRepository
+}
+
+func NewSupplierService(supplierRepository repositories.SupplierRepository, purchaseOrderRepository repositories.PurchaseOrderRepository) SupplierService {
+	return &supplierService{
+		supplierRepository:      supplierRepository,
+		purchaseOrderRepository: purchaseOrderRepository,
+	}
+}
+
+func (s *supplierService) FindAll(search_filter map[string]interface{}) (int, []models.Supplier, error) {
+	suppliers, err := s.supplierRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, suppliers, nil
+}
+
+func (s *supplierService) FindByID(id uint) (int, *models.Supplier, error) {
+	supplier, err := s.supplierRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, supplier, nil
+}
+
+func (s *supplierService) Create(supplier *models.Supplier) (int, *models.Supplier, error) {
+	if _, err := s.supplierRepository.FindByName(supplier.Name); err == nil {
+		return http.StatusConflict, nil, errors.New("이미 등록된 공급업체 이름입니다")
+	}
+
+	createdSupplier, err := s.supplierRepository.Create(supplier)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusCreated, createdSupplier, nil
+}
+
+func (s *supplierService) Update(id uint, supplier *models.Supplier) (int, *models.Supplier, error) {
+	existing, err := s.supplierRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if other, err := s.supplierRepository.FindByName(supplier.Name); err == nil && other.ID != id {
+		return http.StatusConflict, nil, errors.New("이미 등록된 공급업체 이름입니다")
+	}
+
+	supplier.Model = existing.Model
+
+	updatedSupplier, err := s.supplierRepository.Update(supplier)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, updatedSupplier, nil
+}
+
+// 입고가 진행 중인 발주가 있는 공급업체는 삭제 불가
+func (s *supplierService) Delete(id uint) (int, error) {
+	for _, status := range []string{models.PURCHASE_STATUS_ORDERED, models.PURCHASE_STATUS_PARTIALLY_RECEIVED} {
+		purchaseOrders, err := s.purchaseOrderRepository.FindAll(map[string]interface{}{"supplier_id": id, "status": status})
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		if len(purchaseOrders) > 0 {
+			return http.StatusConflict, errors.New("입고가 진행 중인 발주가 있는 공급업체는 삭제할 수 없습니다")
+		}
+	}
+
+	if err := s.supplierRepository.Delete(id); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
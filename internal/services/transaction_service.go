@@ -3,28 +3,52 @@ package services
 import (
 	"github.com/jhphon0730/StockFlow/internal/models"
 	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/internal/ws"
 	"github.com/jhphon0730/StockFlow/pkg/redis"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"gorm.io/gorm"
 
 	"net/http"
 	"context"
+	"errors"
+	"fmt"
+	"log"
 )
 
 type TransactionService interface {
-	FindAll(ctx context.Context, search_filter map[string]interface{}) (int, []models.Transaction, error)
+	FindAll(ctx context.Context, search_filter map[string]interface{}, page models.PageQuery) (int, []models.Transaction, *models.Pagination, error)
 	FindByID(id uint) (int, *models.Transaction, error)
 	Create(transaction *models.Transaction, ctx context.Context) (int, *models.Transaction, error)
-	Delete(id uint, ctx context.Context) (int, error)
+	CreateWithTx(tx *gorm.DB, transaction *models.Transaction) (int, *models.Transaction, error)
+	CreateBatch(transactions []*models.Transaction, lineErrors map[int]error, mode string, ctx context.Context) (int, *models.TransactionBatchResult, error)
+	Transfer(sourceWarehouseID, destinationWarehouseID, productID uint, quantity int, serials []string, ctx context.Context) (int, []models.Transaction, error)
+	Reverse(id uint, ctx context.Context) (int, []models.Transaction, error)
+	NotifyStockAlerts(transactions []models.Transaction)
+	TransactionWithRetry(fn func(tx *gorm.DB) error) error
 }
 
 type transactionService struct {
 	transactionRepository repositories.TransactionRepository
 	inventoryRepository repositories.InventoryRepository
+	warehouseRepository repositories.WarehouseRepository
+	lotRepository repositories.LotRepository
+	serialNumberRepository repositories.SerialNumberRepository
+	binLocationRepository repositories.BinLocationRepository
+	stockAlertRepository repositories.StockAlertRepository
+	costLayerRepository repositories.CostLayerRepository
 }
 
-func NewTransactionService(transactionRepository repositories.TransactionRepository, inventoryRepository repositories.InventoryRepository) TransactionService {
+func NewTransactionService(transactionRepository repositories.TransactionRepository, inventoryRepository repositories.InventoryRepository, warehouseRepository repositories.WarehouseRepository, lotRepository repositories.LotRepository, serialNumberRepository repositories.SerialNumberRepository, binLocationRepository repositories.BinLocationRepository, stockAlertRepository repositories.StockAlertRepository, costLayerRepository repositories.CostLayerRepository) TransactionService {
 	return &transactionService{
 		transactionRepository: transactionRepository,
 		inventoryRepository: inventoryRepository,
+		warehouseRepository: warehouseRepository,
+		lotRepository: lotRepository,
+		serialNumberRepository: serialNumberRepository,
+		binLocationRepository: binLocationRepository,
+		stockAlertRepository: stockAlertRepository,
+		costLayerRepository: costLayerRepository,
 	}
 }
 
@@ -37,26 +61,27 @@ func (t *transactionService) getTransactionRedis(ctx context.Context) redis.Tran
 	return transactionRedis
 }
 
-func (t *transactionService) FindAll(ctx context.Context, search_filter map[string]interface{}) (int, []models.Transaction, error) {
+// 검색 조건이 없는 목록은 페이지 조건별로 캐시
+func (t *transactionService) FindAll(ctx context.Context, search_filter map[string]interface{}, page models.PageQuery) (int, []models.Transaction, *models.Pagination, error) {
 	transactionRedis := t.getTransactionRedis(ctx)
 
 	if transactionRedis != nil && len(search_filter) == 0 {
-		transactions, err := transactionRedis.GetTransactionCache(ctx)
-		if err == nil && len(transactions) > 0 {
-			return http.StatusOK, transactions, nil
+		transactions, pagination, err := transactionRedis.GetTransactionCache(ctx, page)
+		if err == nil && pagination != nil {
+			return http.StatusOK, transactions, pagination, nil
 		}
 	}
 
-	transactions, err := t.transactionRepository.FindAll(search_filter)
+	transactions, pagination, err := t.transactionRepository.FindPage(search_filter, page)
 	if err != nil {
-		return http.StatusInternalServerError, nil, err
+		return http.StatusInternalServerError, nil, nil, err
 	}
 
 	if transactionRedis != nil && len(search_filter) == 0 {
-		_ = transactionRedis.SetTransactionCache(ctx, transactions)
+		_ = transactionRedis.SetTransactionCache(ctx, page, transactions, pagination)
 	}
 
-	return http.StatusOK, transactions, nil
+	return http.StatusOK, transactions, pagination, nil
 }
 
 func (t *transactionService) FindByID(id uint) (int, *models.Transaction, error) {
@@ -68,28 +93,763 @@ func (t *transactionService) FindByID(id uint) (int, *models.Transaction, error)
 	return http.StatusOK, transaction, nil
 }
 
+// 재고 버전 충돌 시 재시도 횟수
+const inventoryConflictRetries = 5
+
+// DB 트랜잭션 실행 ( 재고 버전 충돌로 실패한 경우 처음부터 다시 실행 )
+func (t *transactionService) TransactionWithRetry(fn func(tx *gorm.DB) error) error {
+	var err error
+	for attempt := 0; attempt < inventoryConflictRetries; attempt++ {
+		err = t.transactionRepository.Transaction(fn)
+		if !errors.Is(err, repositories.ErrInventoryVersionConflict) {
+			return err
+		}
+	}
+
+	return err
+}
+
 func (t *transactionService) Create(transaction *models.Transaction, ctx context.Context) (int, *models.Transaction, error) {
-	createdTransaction, err := t.transactionRepository.Create(transaction)
+	var createdTransaction *models.Transaction
+	status := http.StatusCreated
+
+	err := t.TransactionWithRetry(func(tx *gorm.DB) error {
+		// 재시도 시 이전 시도에서 변경된 값 없이 다시 시작
+		attempt := *transaction
+		var err error
+		status, createdTransaction, err = t.createWithTx(tx, &attempt, true)
+		return err
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+	t.NotifyStockAlerts([]models.Transaction{*createdTransaction})
+
+	return http.StatusCreated, createdTransaction, nil
+}
+
+// 여러 재고내역을 하나의 DB 트랜잭션으로 생성 ( 모든 항목에 같은 BATCH 참조 값 사용 )
+// - ATOMIC: 하나라도 실패하면 전체 취소
+// - BEST_EFFORT: 실패한 항목만 SAVEPOINT 로 되돌리고 나머지는 반영 ( 입력값 오류 항목은 lineErrors 로 전달 )
+// - 캐시 초기화와 WebSocket 알림은 커밋 후 한 번만 실행
+func (t *transactionService) CreateBatch(transactions []*models.Transaction, lineErrors map[int]error, mode string, ctx context.Context) (int, *models.TransactionBatchResult, error) {
+	reference, err := utils.GenerateReference("BATCH")
 	if err != nil {
 		return http.StatusInternalServerError, nil, err
 	}
 
-	if err := t.inventoryRepository.UpdateQuantity(createdTransaction.InventoryID, createdTransaction.Quantity, createdTransaction.Type); err != nil {
+	var result *models.TransactionBatchResult
+	status := http.StatusCreated
+
+	err = t.TransactionWithRetry(func(tx *gorm.DB) error {
+		result = &models.TransactionBatchResult{
+			Reference: reference,
+			Mode:      mode,
+			Results:   make([]models.TransactionBatchLineResult, 0, len(transactions)),
+		}
+
+		for i, transaction := range transactions {
+			if lineErr, ok := lineErrors[i]; ok {
+				result.Results = append(result.Results, models.TransactionBatchLineResult{Index: i, Status: http.StatusBadRequest, Error: lineErr.Error()})
+				result.Failed++
+				continue
+			}
+
+			savepoint := fmt.Sprintf("batch_line_%d", i)
+			if mode == models.BATCH_MODE_BEST_EFFORT {
+				if err := tx.SavePoint(savepoint).Error; err != nil {
+					status = http.StatusInternalServerError
+					return err
+				}
+			}
+
+			// 재시도 시 이전 시도에서 변경된 값 없이 다시 시작
+			attempt := *transaction
+			attempt.Reference = reference
+			code, createdTransaction, err := t.createWithTx(tx, &attempt, true)
+			if err != nil {
+				// 재고 버전 충돌은 항목 실패가 아니라 전체 재시도 대상
+				if mode == models.BATCH_MODE_ATOMIC || errors.Is(err, repositories.ErrInventoryVersionConflict) {
+					status = code
+					return fmt.Errorf("%d번째 항목: %w", i+1, err)
+				}
+
+				if err := tx.RollbackTo(savepoint).Error; err != nil {
+					status = http.StatusInternalServerError
+					return err
+				}
+				result.Results = append(result.Results, models.TransactionBatchLineResult{Index: i, Status: code, Error: err.Error()})
+				result.Failed++
+				continue
+			}
+
+			result.Results = append(result.Results, models.TransactionBatchLineResult{Index: i, Status: http.StatusCreated, Transaction: createdTransaction})
+			result.Succeeded++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	if result.Succeeded > 0 {
+		redis.RestoreRedisData(ctx)
+		createdTransactions := result.Transactions()
+		t.NotifyStockAlerts(createdTransactions)
+		t.notifyBatch(reference, createdTransactions)
+	}
+
+	// 일부 항목이 실패한 경우 항목별 결과 확인 필요
+	if result.Failed > 0 {
+		return http.StatusMultiStatus, result, nil
+	}
+
+	return http.StatusCreated, result, nil
+}
+
+// 일괄 처리된 재고내역을 창고 Room 별로 한 번씩 전송 ( 항목마다 전송하지 않음 )
+func (t *transactionService) notifyBatch(reference string, transactions []models.Transaction) {
+	inventoryIDs := make([]uint, 0, len(transactions))
+	for _, transaction := range transactions {
+		inventoryIDs = append(inventoryIDs, transaction.InventoryID)
+	}
+
+	warehouseIDs, err := t.inventoryRepository.FindWarehouseIDs(inventoryIDs)
+	if err != nil {
+		log.Printf("Failed to find warehouses for batch notification: %v", err)
+		return
+	}
+
+	warehouseTransactions := make(map[uint][]uint)
+	for _, transaction := range transactions {
+		warehouseID, ok := warehouseIDs[transaction.InventoryID]
+		if !ok {
+			continue
+		}
+		warehouseTransactions[warehouseID] = append(warehouseTransactions[warehouseID], transaction.ID)
+	}
+
+	for warehouseID, transactionIDs := range warehouseTransactions {
+		ws.GetManager().Broadcast(ws.WarehouseRoomID(warehouseID), "transaction_batch", map[string]interface{}{
+			"reference":       reference,
+			"transaction_ids": transactionIDs,
+		})
+	}
+}
+
+// 외부 DB 트랜잭션 안에서 재고내역을 생성하고 재고 수량을 반영 ( 캐시 초기화는 호출자가 담당 )
+// - 다른 서비스가 생성하는 재고내역용으로, 로트 번호 없는 조정(ADJUST)은 FEFO 로 차감하고 증가 수량은 기본 로트에 입고
+func (t *transactionService) CreateWithTx(tx *gorm.DB, transaction *models.Transaction) (int, *models.Transaction, error) {
+	return t.createWithTx(tx, transaction, false)
+}
+
+// manual: 사용자가 직접 요청한 재고내역 여부 ( 로트 관리 제품 조정 시 로트 번호 필수 )
+func (t *transactionService) createWithTx(tx *gorm.DB, transaction *models.Transaction, manual bool) (int, *models.Transaction, error) {
+	inventoryRepository := t.inventoryRepository.WithTx(tx)
+
+	// 재고 행을 잠근 상태에서 음수 재고 정책 확인
+	inventory, err := inventoryRepository.FindByIDForUpdate(transaction.InventoryID)
+	if err != nil {
+		return http.StatusBadRequest, nil, errors.New("존재하지 않는 재고입니다")
+	}
+
+	// 지정 단위의 수량과 단가를 기본 단위로 환산
+	if transaction.Unit != "" && inventory.Product != nil {
+		factor, err := inventory.Product.UnitFactor(transaction.Unit)
+		if err != nil {
+			return http.StatusBadRequest, nil, err
+		}
+
+		transaction.UnitQuantity = transaction.Quantity
+		transaction.Quantity *= factor
+		transaction.UnitCost /= float64(factor)
+	}
+
+	warning, err := checkNegativeStock(inventory, transaction)
+	if err != nil {
+		return http.StatusConflict, nil, err
+	}
+
+	// 예약된 수량은 예약 주체가 예약을 소진한 뒤에만 출고 가능
+	if transaction.Type == "OUT" && inventory.ReservedQuantity > 0 && transaction.Quantity > inventory.Quantity-inventory.ReservedQuantity {
+		return http.StatusConflict, nil, fmt.Errorf("예약된 재고를 제외한 가용 수량이 부족합니다 (가용 수량: %d, 요청 수량: %d)", inventory.Quantity-inventory.ReservedQuantity, transaction.Quantity)
+	}
+
+	lotTracked := inventory.Product != nil && inventory.Product.LotTracked
+	if lotTracked && transaction.Type == "IN" && transaction.LotNumber == "" {
+		return http.StatusBadRequest, nil, errors.New("로트 관리 제품은 입고 시 로트 번호가 필요합니다")
+	}
+	if lotTracked && manual && transaction.Type == "ADJUST" && transaction.LotNumber == "" && transaction.Quantity != inventory.Quantity {
+		return http.StatusBadRequest, nil, errors.New("로트 관리 제품은 조정 시 로트 번호가 필요합니다")
+	}
+
+	serialized := inventory.Product != nil && inventory.Product.Serialized && transaction.Type != "ADJUST"
+	if serialized && len(transaction.Serials) != transaction.Quantity {
+		return http.StatusBadRequest, nil, fmt.Errorf("일련번호 관리 제품은 수량만큼 일련번호가 필요합니다 (수량: %d, 일련번호: %d)", transaction.Quantity, len(transaction.Serials))
+	}
+
+	if transaction.BinLocationID != nil {
+		if transaction.Type == "ADJUST" {
+			return http.StatusBadRequest, nil, errors.New("조정(ADJUST)은 보관 위치를 지정할 수 없습니다")
+		}
+
+		if code, err := checkBinLocation(t.binLocationRepository.WithTx(tx), inventory, *transaction.BinLocationID); err != nil {
+			return code, nil, err
+		}
+	}
+
+	transaction.QuantityBefore = inventory.Quantity
+	createdTransaction, err := t.transactionRepository.WithTx(tx).Create(transaction)
+	if err != nil {
 		return http.StatusInternalServerError, nil, err
 	}
 
-	redis.RestoreRedisData(ctx)
+	if lotTracked {
+		if code, err := t.applyLots(tx, createdTransaction); err != nil {
+			return code, nil, err
+		}
+	}
+
+	if serialized {
+		if code, err := t.applySerials(tx, inventory, createdTransaction); err != nil {
+			return code, nil, err
+		}
+	}
+
+	if createdTransaction.BinLocationID != nil {
+		if code, err := t.applyBinStock(tx, createdTransaction); err != nil {
+			return code, nil, err
+		}
+	}
+
+	if code, err := t.applyCost(tx, inventory, createdTransaction); err != nil {
+		return code, nil, err
+	}
+
+	// 재고내역과 같은 DB 트랜잭션 안에서, 잠금 후 조회한 버전 그대로인 경우에만 수량 변경
+	previous := inventory.Quantity
+	next := inventory.NextQuantity(createdTransaction.Type, createdTransaction.Quantity)
+	if err := inventoryRepository.UpdateQuantity(inventory.ID, next, inventory.Version); err != nil {
+		if errors.Is(err, repositories.ErrInventoryVersionConflict) {
+			return http.StatusConflict, nil, err
+		}
+		return http.StatusInternalServerError, nil, err
+	}
+	inventory.Quantity = next
+	inventory.Version++
+
+	// 임계치를 넘어선 경우 알림 저장 ( 전송은 커밋 후 NotifyStockAlerts 에서 처리 )
+	if level, threshold := inventory.CrossedThreshold(previous); level != "" {
+		stockAlert, err := t.stockAlertRepository.WithTx(tx).Create(&models.StockAlert{
+			InventoryID:   inventory.ID,
+			WarehouseID:   inventory.WarehouseID,
+			ProductID:     inventory.ProductID,
+			TransactionID: createdTransaction.ID,
+			Level:         level,
+			Quantity:      inventory.Quantity,
+			Threshold:     threshold,
+		})
+		if err != nil {
+			return http.StatusInternalServerError, nil, err
+		}
+		createdTransaction.StockAlert = stockAlert
+	}
+
+	createdTransaction.Warning = warning
 
 	return http.StatusCreated, createdTransaction, nil
 }
 
-func (t *transactionService) Delete(id uint, ctx context.Context) (int, error) {
-	err := t.transactionRepository.Delete(id)
+// 로트 관리 제품의 로트 수량 반영
+// - IN: 지정 로트에 입고, OUT: 지정 로트 또는 유통기한이 빠른 로트부터 소진
+// - ADJUST: 변경량만큼 지정 로트에 입고하거나 지정 로트에서 소진 ( 로트 미지정 시 기본 로트에 입고, 유통기한이 빠른 로트부터 소진 )
+func (t *transactionService) applyLots(tx *gorm.DB, transaction *models.Transaction) (int, error) {
+	lotRepository := t.lotRepository.WithTx(tx)
+
+	quantity := transaction.QuantityDelta()
+	if quantity == 0 {
+		return http.StatusCreated, nil
+	}
+
+	if quantity > 0 {
+		lotNumber := transaction.LotNumber
+		if lotNumber == "" {
+			lotNumber = models.DEFAULT_LOT_NUMBER
+		}
+
+		lot, err := lotRepository.FindOrCreate(transaction.InventoryID, lotNumber, transaction.ManufacturedAt, transaction.ExpiresAt)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		if err := t.consumeLot(lotRepository, transaction, lot, quantity); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		return http.StatusCreated, nil
+	}
+
+	lots, err := lotRepository.FindAvailableForUpdate(transaction.InventoryID)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	remaining := -quantity
+	for i := range lots {
+		if remaining == 0 {
+			break
+		}
+		if transaction.LotNumber != "" && lots[i].LotNumber != transaction.LotNumber {
+			continue
+		}
+
+		take := min(remaining, lots[i].Quantity)
+		if err := t.consumeLot(lotRepository, transaction, &lots[i], -take); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		remaining -= take
+	}
+
+	if remaining > 0 {
+		return http.StatusConflict, fmt.Errorf("로트 재고가 부족합니다 (부족 수량: %d)", remaining)
+	}
+
+	return http.StatusCreated, nil
+}
+
+// 로트 수량을 delta 만큼 변경하고 재고내역-로트 기록 생성
+func (t *transactionService) consumeLot(lotRepository repositories.LotRepository, transaction *models.Transaction, lot *models.Lot, delta int) error {
+	if err := lotRepository.AddQuantity(lot.ID, delta); err != nil {
+		return err
+	}
+	lot.Quantity += delta
+
+	quantity := delta
+	if quantity < 0 {
+		quantity = -quantity
+	}
+
+	transactionLot := models.TransactionLot{
+		TransactionID: transaction.ID,
+		LotID:         lot.ID,
+		Quantity:      quantity,
+	}
+	if err := lotRepository.CreateTransactionLot(&transactionLot); err != nil {
+		return err
+	}
+
+	transactionLot.Lot = lot
+	transaction.Lots = append(transaction.Lots, transactionLot)
+
+	return nil
+}
+
+// 일련번호 관리 제품의 일련번호 반영 ( IN: 신규 등록 또는 출고된 일련번호 재입고, OUT: 해당 재고에 보관 중인 일련번호만 출고 )
+func (t *transactionService) applySerials(tx *gorm.DB, inventory *models.Inventory, transaction *models.Transaction) (int, error) {
+	serialNumberRepository := t.serialNumberRepository.WithTx(tx)
+
+	existing, err := serialNumberRepository.FindBySerialsForUpdate(transaction.Serials)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	existingBySerial := make(map[string]models.SerialNumber, len(existing))
+	for _, serialNumber := range existing {
+		existingBySerial[serialNumber.Serial] = serialNumber
+	}
+
+	serialNumbers := make([]models.SerialNumber, 0, len(transaction.Serials))
+	seen := make(map[string]bool, len(transaction.Serials))
+	for _, serial := range transaction.Serials {
+		if seen[serial] {
+			return http.StatusBadRequest, fmt.Errorf("중복된 일련번호입니다 (%s)", serial)
+		}
+		seen[serial] = true
+
+		serialNumber, exists := existingBySerial[serial]
+		switch transaction.Type {
+		case "IN":
+			if exists && serialNumber.Status == models.SERIAL_STATUS_IN_STOCK {
+				return http.StatusConflict, fmt.Errorf("이미 재고에 등록된 일련번호입니다 (%s)", serial)
+			}
+			if exists && serialNumber.ProductID != inventory.ProductID {
+				return http.StatusConflict, fmt.Errorf("다른 제품에 등록된 일련번호입니다 (%s)", serial)
+			}
+			if !exists {
+				serialNumber = models.SerialNumber{ProductID: inventory.ProductID, Serial: serial}
+			}
+			serialNumber.InventoryID = inventory.ID
+			serialNumber.Status = models.SERIAL_STATUS_IN_STOCK
+		case "OUT":
+			if !exists || serialNumber.InventoryID != inventory.ID || serialNumber.Status != models.SERIAL_STATUS_IN_STOCK {
+				return http.StatusConflict, fmt.Errorf("해당 재고에 보관 중인 일련번호가 아닙니다 (%s)", serial)
+			}
+			serialNumber.Status = models.SERIAL_STATUS_OUT
+		}
+
+		if err := serialNumberRepository.Save(&serialNumber); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		serialNumbers = append(serialNumbers, serialNumber)
+	}
+
+	if err := serialNumberRepository.AppendTransaction(transaction, serialNumbers); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusCreated, nil
+}
+
+// 보관 위치별 재고 수량 반영 ( IN: 위치에 추가, OUT: 위치 재고 범위 안에서 차감 )
+func (t *transactionService) applyBinStock(tx *gorm.DB, transaction *models.Transaction) (int, error) {
+	binLocationRepository := t.binLocationRepository.WithTx(tx)
+
+	binStock, err := binLocationRepository.FindStockForUpdate(transaction.InventoryID, *transaction.BinLocationID)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
 
+	delta := transaction.Quantity
+	if transaction.Type == "OUT" {
+		if binStock.Quantity < transaction.Quantity {
+			return http.StatusConflict, fmt.Errorf("보관 위치 재고가 부족합니다 (현재 수량: %d, 요청 수량: %d)", binStock.Quantity, transaction.Quantity)
+		}
+		delta = -transaction.Quantity
+	}
+
+	if err := binLocationRepository.AddStockQuantity(transaction.InventoryID, *transaction.BinLocationID, delta); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusCreated, nil
+}
+
+// 원가층과 재고 단위 원가 반영 ( 증가: 원가층 추가, 감소: FIFO 는 먼저 입고된 원가층부터 / AVERAGE 는 이동평균 원가로 매출원가 계산 )
+func (t *transactionService) applyCost(tx *gorm.DB, inventory *models.Inventory, transaction *models.Transaction) (int, error) {
+	costLayerRepository := t.costLayerRepository.WithTx(tx)
+
+	method := models.COST_METHOD_FIFO
+	if inventory.Product != nil && inventory.Product.CostMethod != "" {
+		method = inventory.Product.CostMethod
+	}
+
+	delta := inventory.NextQuantity(transaction.Type, transaction.Quantity) - inventory.Quantity
+	averageCost := inventory.AverageCost
+
+	switch {
+	case delta > 0:
+		// 단가를 지정하지 않은 조정 증가분은 현재 단위 원가로 평가
+		if transaction.Type == "ADJUST" && transaction.UnitCost == 0 {
+			transaction.UnitCost = inventory.AverageCost
+		}
+
+		costLayer := models.CostLayer{
+			InventoryID:       inventory.ID,
+			TransactionID:     transaction.ID,
+			UnitCost:          transaction.UnitCost,
+			Quantity:          delta,
+			RemainingQuantity: delta,
+			ReceivedAt:        transaction.Timestamp,
+		}
+		if _, err := costLayerRepository.Create(&costLayer); err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		onHand := max(inventory.Quantity, 0)
+		averageCost = (float64(onHand)*inventory.AverageCost + float64(delta)*transaction.UnitCost) / float64(onHand+delta)
+	case delta < 0:
+		costLayers, err := costLayerRepository.FindAvailableForUpdate(inventory.ID)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		remaining := -delta
+		layerCost := 0.0
+		for i := range costLayers {
+			if remaining == 0 {
+				break
+			}
+
+			take := min(remaining, costLayers[i].RemainingQuantity)
+			if err := costLayerRepository.AddRemainingQuantity(costLayers[i].ID, -take); err != nil {
+				return http.StatusInternalServerError, err
+			}
+			layerCost += float64(take) * costLayers[i].UnitCost
+			remaining -= take
+		}
+		// 원가층이 부족한 수량 ( 음수 재고 허용 ) 은 현재 단위 원가로 평가
+		layerCost += float64(remaining) * inventory.AverageCost
+
+		transaction.CostOfGoods = layerCost
+		if method == models.COST_METHOD_AVERAGE {
+			transaction.CostOfGoods = float64(-delta) * inventory.AverageCost
+		}
+		transaction.UnitCost = transaction.CostOfGoods / float64(-delta)
+	default:
+		return http.StatusCreated, nil
+	}
+
+	// FIFO 는 남은 원가층의 평균 단가를 재고 단위 원가로 사용
+	if method == models.COST_METHOD_FIFO {
+		quantity, value, err := costLayerRepository.GetRemainingValue(inventory.ID)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		if quantity > 0 {
+			averageCost = value / float64(quantity)
+		}
+	}
+
+	if err := t.transactionRepository.WithTx(tx).UpdateCost(transaction.ID, transaction.UnitCost, transaction.CostOfGoods); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if err := t.inventoryRepository.WithTx(tx).UpdateAverageCost(inventory.ID, averageCost); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	inventory.AverageCost = averageCost
+
+	return http.StatusCreated, nil
+}
+
+// 반영 후 수량이 음수가 되는 경우 창고의 음수 재고 정책에 따라 거부하거나 경고 반환
+func checkNegativeStock(inventory *models.Inventory, transaction *models.Transaction) (string, error) {
+	next := inventory.NextQuantity(transaction.Type, transaction.Quantity)
+	if next >= 0 {
+		return "", nil
+	}
+
+	policy := models.NEGATIVE_STOCK_FORBID
+	if inventory.Warehouse != nil && inventory.Warehouse.NegativeStockPolicy != "" {
+		policy = inventory.Warehouse.NegativeStockPolicy
+	}
+
+	switch policy {
+	case models.NEGATIVE_STOCK_ALLOW:
+		return "", nil
+	case models.NEGATIVE_STOCK_WARN:
+		warning := fmt.Sprintf("재고가 음수가 됩니다 (현재 수량: %d, 반영 후 수량: %d)", inventory.Quantity, next)
+		log.Printf("Negative stock warning (inventory_id: %d): %s\n", inventory.ID, warning)
+		return warning, nil
+	}
+
+	return "", fmt.Errorf("재고가 부족합니다 (현재 수량: %d, 요청 수량: %d)", inventory.Quantity, transaction.Quantity)
+}
+
+// 창고 간 재고 이동 ( 출발 창고 OUT + 도착 창고 IN 을 하나의 DB 트랜잭션으로 처리, 입고 단가는 출고 단가를 그대로 사용 )
+func (t *transactionService) Transfer(sourceWarehouseID, destinationWarehouseID, productID uint, quantity int, serials []string, ctx context.Context) (int, []models.Transaction, error) {
+	if _, err := t.warehouseRepository.FindByID(destinationWarehouseID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusNotFound, nil, errors.New("존재하지 않는 도착 창고입니다")
+		}
+		return http.StatusInternalServerError, nil, err
+	}
+
+	reference, err := utils.GenerateReference("TRANSFER")
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	var transactions []models.Transaction
+	status := http.StatusCreated
+
+	err = t.TransactionWithRetry(func(tx *gorm.DB) error {
+		transactions = nil
+		inventoryRepository := t.inventoryRepository.WithTx(tx)
+
+		source, err := inventoryRepository.FindByWarehouseAndProduct(sourceWarehouseID, productID)
+		if err != nil {
+			status = http.StatusBadRequest
+			return errors.New("출발 창고에 해당 제품의 재고가 없습니다")
+		}
+
+		destination, err := inventoryRepository.FindOrCreate(destinationWarehouseID, productID)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		now := models.GetNowTime()
+		code, out, err := t.CreateWithTx(tx, &models.Transaction{
+			InventoryID: source.ID, Type: "OUT", Quantity: quantity, Timestamp: now, Reference: reference, Serials: serials,
+		})
+		if err != nil {
+			status = code
+			return err
+		}
+		transactions = append(transactions, *out)
+
+		// 로트 관리 제품은 출발 창고에서 소진된 로트를 그대로 도착 창고에 입고
+		ins := []*models.Transaction{
+			{InventoryID: destination.ID, Type: "IN", Quantity: quantity, Timestamp: now, Reference: reference, Serials: serials, UnitCost: out.UnitCost},
+		}
+		if len(out.Lots) > 0 {
+			ins = ins[:0]
+			remainingSerials := serials
+			for _, transactionLot := range out.Lots {
+				// 일련번호도 함께 관리하는 경우 로트 수량만큼 순서대로 나누어 입고
+				var lotSerials []string
+				if len(remainingSerials) >= transactionLot.Quantity {
+					lotSerials, remainingSerials = remainingSerials[:transactionLot.Quantity], remainingSerials[transactionLot.Quantity:]
+				}
+
+				ins = append(ins, &models.Transaction{
+					InventoryID:    destination.ID,
+					Type:           "IN",
+					Quantity:       transactionLot.Quantity,
+					Timestamp:      now,
+					Reference:      reference,
+					LotNumber:      transactionLot.Lot.LotNumber,
+					ManufacturedAt: transactionLot.Lot.ManufacturedAt,
+					ExpiresAt:      transactionLot.Lot.ExpiresAt,
+					Serials:        lotSerials,
+					UnitCost:       out.UnitCost,
+				})
+			}
+		}
+
+		for _, in := range ins {
+			code, createdTransaction, err := t.CreateWithTx(tx, in)
+			if err != nil {
+				status = code
+				return err
+			}
+			transactions = append(transactions, *createdTransaction)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
 	redis.RestoreRedisData(ctx)
+	t.NotifyStockAlerts(transactions)
+
+	return http.StatusCreated, transactions, nil
+}
+
+// 재고내역 취소 ( 원본은 삭제하지 않고 반대 방향의 재고내역을 생성한 뒤 취소 상태로 표시 )
+// - 로트 관리 제품은 원본의 로트별로, 일련번호 관리 제품은 원본의 일련번호로 되돌림
+func (t *transactionService) Reverse(id uint, ctx context.Context) (int, []models.Transaction, error) {
+	reference, err := utils.GenerateReference("REVERSAL")
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	transactions := []models.Transaction{}
+	status := http.StatusCreated
+
+	err = t.TransactionWithRetry(func(tx *gorm.DB) error {
+		transactions = transactions[:0]
+		transactionRepository := t.transactionRepository.WithTx(tx)
+
+		original, err := transactionRepository.FindByIDForUpdate(id)
+		if err != nil {
+			status = http.StatusInternalServerError
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				status = http.StatusNotFound
+			}
+			return err
+		}
+
+		if original.ReversalOfID != nil {
+			status = http.StatusConflict
+			return errors.New("취소로 생성된 재고내역은 취소할 수 없습니다")
+		}
+		if original.ReversedAt != nil {
+			status = http.StatusConflict
+			return errors.New("이미 취소된 재고내역입니다")
+		}
 
-	return http.StatusOK, nil
+		for _, compensation := range reversalTransactions(original, reference) {
+			code, createdTransaction, err := t.CreateWithTx(tx, compensation)
+			if err != nil {
+				status = code
+				return err
+			}
+			transactions = append(transactions, *createdTransaction)
+		}
+
+		if err := transactionRepository.MarkReversed(original.ID, models.GetNowTime()); err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+	t.NotifyStockAlerts(transactions)
+
+	return http.StatusCreated, transactions, nil
+}
+
+// 원본 재고내역의 변경량을 되돌리는 재고내역 목록 ( 변경량이 없으면 빈 목록 )
+func reversalTransactions(original *models.Transaction, reference string) []*models.Transaction {
+	delta := original.QuantityDelta()
+	if delta == 0 {
+		return nil
+	}
+
+	transactionType, quantity := "OUT", delta
+	if delta < 0 {
+		transactionType, quantity = "IN", -delta
+	}
+
+	serials := make([]string, 0, len(original.SerialNumbers))
+	for _, serialNumber := range original.SerialNumbers {
+		serials = append(serials, serialNumber.Serial)
+	}
+
+	base := models.Transaction{
+		InventoryID:   original.InventoryID,
+		Type:          transactionType,
+		Quantity:      quantity,
+		Timestamp:     models.GetNowTime(),
+		Reference:     reference,
+		ReversalOfID:  &original.ID,
+		BinLocationID: original.BinLocationID,
+		UnitCost:      original.UnitCost, // 출고 취소는 출고 단가로 재입고
+	}
+	if len(original.Lots) == 0 {
+		if len(serials) > 0 {
+			base.Serials = serials
+		}
+		return []*models.Transaction{&base}
+	}
+
+	// 로트별로 나누어 되돌림 ( 일련번호도 함께 관리하는 경우 로트 수량만큼 순서대로 배분 )
+	var compensations []*models.Transaction
+	for _, transactionLot := range original.Lots {
+		compensation := base
+		compensation.Quantity = transactionLot.Quantity
+		if transactionLot.Lot != nil {
+			compensation.LotNumber = transactionLot.Lot.LotNumber
+			compensation.ManufacturedAt = transactionLot.Lot.ManufacturedAt
+			compensation.ExpiresAt = transactionLot.Lot.ExpiresAt
+		}
+		if len(serials) >= transactionLot.Quantity {
+			compensation.Serials, serials = serials[:transactionLot.Quantity], serials[transactionLot.Quantity:]
+		}
+		compensations = append(compensations, &compensation)
+	}
+
+	return compensations
+}
+
+// 커밋된 재고내역의 임계치 알림을 창고 Room 으로 전송
+func (t *transactionService) NotifyStockAlerts(transactions []models.Transaction) {
+	for _, transaction := range transactions {
+		if transaction.StockAlert == nil {
+			continue
+		}
+
+		ws.GetManager().Broadcast(ws.WarehouseRoomID(transaction.StockAlert.WarehouseID), "stock_alert", transaction.StockAlert)
+	}
 }
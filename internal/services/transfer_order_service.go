@@ -0,0 +1,469 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/pkg/redis"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"gorm.io/gorm"
+
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type TransferOrderService interface {
+	FindAll(search_filter map[string]interface{}) (int, []models.TransferOrder, error)
+	FindByID(id uint) (int, *models.TransferOrder, error)
+	Create(transferOrder *models.TransferOrder) (int, *models.TransferOrder, error)
+	Dispatch(id uint, serials map[uint][]string, ctx context.Context) (int, *models.TransferOrder, error)
+	Receive(id uint, receipts map[uint]models.TransferOrderReceipt, ctx context.Context) (int, *models.TransferOrder, error)
+	Cancel(id uint) (int, *models.TransferOrder, error)
+}
+
+type transferOrderService struct {
+	transferOrderRepository repositories.TransferOrderRepository
+	inventoryRepository     repositories.InventoryRepository
+	transactionRepository   repositories.TransactionRepository
+	transactionService      TransactionService
+}
+
+func NewTransferOrderService(
+	transferOrderRepository repositories.TransferOrderRepository,
+	inventoryRepository repositories.InventoryRepository,
+	transactionRepository repositories.TransactionRepository,
+	transactionService TransactionService,
+) TransferOrderService {
+	return &transferOrderService{
+		transferOrderRepository: transferOrderRepository,
+		inventoryRepository:     inventoryRepository,
+		transactionRepository:   transactionRepository,
+		transactionService:      transactionService,
+	}
+}
+
+func (t *transferOrderService) FindAll(search_filter map[string]interface{}) (int, []models.TransferOrder, error) {
+	transferOrders, err := t.transferOrderRepository.FindAll(search_filter)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, transferOrders, nil
+}
+
+func (t *transferOrderService) FindByID(id uint) (int, *models.TransferOrder, error) {
+	transferOrder, err := t.transferOrderRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, transferOrder, nil
+}
+
+func (t *transferOrderService) Create(transferOrder *models.TransferOrder) (int, *models.TransferOrder, error) {
+	// 이동할 제품이 출발 창고에 재고로 등록되어 있는지 확인
+	for _, item := range transferOrder.Items {
+		if _, err := t.inventoryRepository.FindByWarehouseAndProduct(transferOrder.SourceWarehouseID, item.ProductID); err != nil {
+			return http.StatusBadRequest, nil, fmt.Errorf("출발 창고에 등록되지 않은 제품입니다 (product_id: %d)", item.ProductID)
+		}
+	}
+
+	reference, err := utils.GenerateReference("TRANSFER")
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	transferOrder.Status = models.TRANSFER_STATUS_DRAFT
+	transferOrder.Reference = reference
+
+	createdTransferOrder, err := t.transferOrderRepository.Create(transferOrder)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusCreated, createdTransferOrder, nil
+}
+
+// 출발 창고에서 전체 항목을 출고(OUT)하고 이동 중 상태로 변경 ( 이동 지시 행을 잠근 상태에서 상태 확인 )
+// - serials: 항목 ID -> 출고 일련번호 ( 일련번호 관리 제품 필수 )
+func (t *transferOrderService) Dispatch(id uint, serials map[uint][]string, ctx context.Context) (int, *models.TransferOrder, error) {
+	var transactions []models.Transaction
+	status := http.StatusOK
+	err := t.transactionService.TransactionWithRetry(func(tx *gorm.DB) error {
+		transactions = nil
+		transferOrderRepository := t.transferOrderRepository.WithTx(tx)
+
+		transferOrder, err := transferOrderRepository.FindByIDForUpdate(id)
+		if err != nil {
+			status = http.StatusInternalServerError
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				status = http.StatusNotFound
+			}
+			return err
+		}
+
+		if transferOrder.Status != models.TRANSFER_STATUS_DRAFT {
+			status = http.StatusConflict
+			return errors.New("작성 중인 이동 지시만 출고할 수 있습니다")
+		}
+
+		if code, err := checkItemSerials(transferOrder.Items, serials); err != nil {
+			status = code
+			return err
+		}
+
+		for _, item := range transferOrder.Items {
+			inventory, err := t.inventoryRepository.WithTx(tx).FindByWarehouseAndProduct(transferOrder.SourceWarehouseID, item.ProductID)
+			if err != nil {
+				status = http.StatusBadRequest
+				return fmt.Errorf("출발 창고에 등록되지 않은 제품입니다 (product_id: %d)", item.ProductID)
+			}
+
+			transaction := &models.Transaction{
+				InventoryID: inventory.ID,
+				Type:        "OUT",
+				Quantity:    item.Quantity,
+				Timestamp:   models.GetNowTime(),
+				Reference:   transferOrder.Reference,
+				Serials:     serials[item.ID],
+			}
+			code, createdTransaction, err := t.transactionService.CreateWithTx(tx, transaction)
+			if err != nil {
+				status = code
+				return err
+			}
+			transactions = append(transactions, *createdTransaction)
+
+			// 출고 단가를 저장해 두었다가 도착 창고 입고 단가로 사용
+			if err := transferOrderRepository.UpdateItemUnitCost(item.ID, createdTransaction.UnitCost); err != nil {
+				status = http.StatusInternalServerError
+				return err
+			}
+		}
+
+		updated, err := transferOrderRepository.UpdateStatus(id, models.TRANSFER_STATUS_DRAFT, models.TRANSFER_STATUS_IN_TRANSIT)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		if !updated {
+			status = http.StatusConflict
+			return errors.New("작성 중인 이동 지시만 출고할 수 있습니다")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+	t.transactionService.NotifyStockAlerts(transactions)
+
+	return t.FindByID(id)
+}
+
+// 도착 창고에 항목별 수량을 입고(IN) ( 전체 수량이 입고되면 입고 완료 상태로 변경 )
+// - 이동 지시와 항목 행을 잠근 상태에서 남은 수량을 확인해 동시 입고로 출고 수량보다 많이 입고되지 않도록 처리
+// - 로트와 일련번호는 출고된 것 중 아직 입고되지 않은 것만 입고 ( 로트 정보는 출고된 로트 그대로 사용 )
+func (t *transferOrderService) Receive(id uint, receipts map[uint]models.TransferOrderReceipt, ctx context.Context) (int, *models.TransferOrder, error) {
+	var transactions []models.Transaction
+	status := http.StatusOK
+	err := t.transactionService.TransactionWithRetry(func(tx *gorm.DB) error {
+		transactions = nil
+		transferOrderRepository := t.transferOrderRepository.WithTx(tx)
+
+		transferOrder, err := transferOrderRepository.FindByIDForUpdate(id)
+		if err != nil {
+			status = http.StatusInternalServerError
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				status = http.StatusNotFound
+			}
+			return err
+		}
+
+		if transferOrder.Status != models.TRANSFER_STATUS_IN_TRANSIT && transferOrder.Status != models.TRANSFER_STATUS_PARTIALLY_RECEIVED {
+			status = http.StatusConflict
+			return errors.New("이동 중인 이동 지시만 입고할 수 있습니다")
+		}
+
+		items := make(map[uint]models.TransferOrderItem)
+		for _, item := range transferOrder.Items {
+			items[item.ID] = item
+		}
+
+		for itemID, receipt := range receipts {
+			item, ok := items[itemID]
+			if !ok {
+				status = http.StatusBadRequest
+				return fmt.Errorf("이동 지시에 포함되지 않은 항목입니다 (item_id: %d)", itemID)
+			}
+
+			if receipt.Quantity > item.RemainingQuantity() {
+				status = http.StatusBadRequest
+				return fmt.Errorf("입고 수량이 남은 이동 수량보다 많습니다 (item_id: %d)", itemID)
+			}
+		}
+
+		dispatched, err := t.findDispatchedStock(tx, transferOrder)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		remaining := 0
+		for _, item := range transferOrder.Items {
+			receipt := receipts[item.ID]
+			quantity := receipt.Quantity
+			remaining += item.RemainingQuantity() - quantity
+			if quantity == 0 {
+				continue
+			}
+
+			inventory, err := t.inventoryRepository.WithTx(tx).FindOrCreate(transferOrder.DestinationWarehouseID, item.ProductID)
+			if err != nil {
+				status = http.StatusInternalServerError
+				return err
+			}
+
+			ins, err := dispatched.receive(item.ProductID, receipt)
+			if err != nil {
+				status = http.StatusBadRequest
+				return fmt.Errorf("%w (item_id: %d)", err, item.ID)
+			}
+
+			for _, in := range ins {
+				in.InventoryID = inventory.ID
+				in.Timestamp = models.GetNowTime()
+				in.Reference = transferOrder.Reference
+				in.UnitCost = item.UnitCost
+
+				code, createdTransaction, err := t.transactionService.CreateWithTx(tx, in)
+				if err != nil {
+					status = code
+					return err
+				}
+				transactions = append(transactions, *createdTransaction)
+			}
+
+			added, err := transferOrderRepository.AddReceivedQuantity(item.ID, quantity)
+			if err != nil {
+				status = http.StatusInternalServerError
+				return err
+			}
+			if !added {
+				status = http.StatusConflict
+				return fmt.Errorf("입고 수량이 남은 이동 수량보다 많습니다 (item_id: %d)", item.ID)
+			}
+		}
+
+		next := models.TRANSFER_STATUS_PARTIALLY_RECEIVED
+		if remaining == 0 {
+			next = models.TRANSFER_STATUS_RECEIVED
+		}
+
+		updated, err := transferOrderRepository.UpdateStatus(id, transferOrder.Status, next)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		if !updated {
+			status = http.StatusConflict
+			return errors.New("이동 중인 이동 지시만 입고할 수 있습니다")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+	t.transactionService.NotifyStockAlerts(transactions)
+
+	return t.FindByID(id)
+}
+
+// 출고 전 이동 지시 취소
+func (t *transferOrderService) Cancel(id uint) (int, *models.TransferOrder, error) {
+	transferOrder, err := t.transferOrderRepository.FindByID(id)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if transferOrder.Status != models.TRANSFER_STATUS_DRAFT {
+		return http.StatusConflict, nil, errors.New("작성 중인 이동 지시만 취소할 수 있습니다")
+	}
+
+	// 그 사이 출고된 경우 취소하지 않음
+	cancelled, err := t.transferOrderRepository.UpdateStatus(id, models.TRANSFER_STATUS_DRAFT, models.TRANSFER_STATUS_CANCELLED)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+	if !cancelled {
+		return http.StatusConflict, nil, errors.New("작성 중인 이동 지시만 취소할 수 있습니다")
+	}
+
+	return t.FindByID(id)
+}
+
+// 일련번호를 입력한 항목이 이동 지시에 포함되어 있는지 확인
+func checkItemSerials(items []models.TransferOrderItem, serials map[uint][]string) (int, error) {
+	itemIDs := make(map[uint]bool, len(items))
+	for _, item := range items {
+		itemIDs[item.ID] = true
+	}
+
+	for itemID := range serials {
+		if !itemIDs[itemID] {
+			return http.StatusBadRequest, fmt.Errorf("이동 지시에 포함되지 않은 항목입니다 (item_id: %d)", itemID)
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// 이동 지시로 출고되었지만 아직 도착 창고에 입고되지 않은 제품별 로트와 일련번호
+type dispatchedStock map[uint]*dispatchedProductStock
+
+type dispatchedProductStock struct {
+	lots    []models.Lot // 출고 순서, Quantity 는 아직 입고되지 않은 수량
+	serials map[string]bool
+}
+
+// 이동 지시 참조 값의 출발 창고 출고(OUT)에서 도착 창고 입고(IN)를 뺀 로트/일련번호 계산
+func (t *transferOrderService) findDispatchedStock(tx *gorm.DB, transferOrder *models.TransferOrder) (dispatchedStock, error) {
+	transactions, err := t.transactionRepository.WithTx(tx).FindByReferenceWithDetails(transferOrder.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	inventoryRepository := t.inventoryRepository.WithTx(tx)
+	stock := make(dispatchedStock)
+	for _, item := range transferOrder.Items {
+		if _, ok := stock[item.ProductID]; ok {
+			continue
+		}
+
+		productStock := &dispatchedProductStock{serials: make(map[string]bool)}
+		stock[item.ProductID] = productStock
+
+		source, err := inventoryRepository.FindByWarehouseAndProduct(transferOrder.SourceWarehouseID, item.ProductID)
+		if err != nil {
+			continue
+		}
+		var destinationID uint
+		if destination, err := inventoryRepository.FindByWarehouseAndProduct(transferOrder.DestinationWarehouseID, item.ProductID); err == nil {
+			destinationID = destination.ID
+		}
+
+		for _, transaction := range transactions {
+			var sign int
+			switch {
+			case transaction.InventoryID == source.ID && transaction.Type == "OUT":
+				sign = 1
+			case transaction.InventoryID == destinationID && transaction.Type == "IN":
+				sign = -1
+			default:
+				continue
+			}
+
+			for _, transactionLot := range transaction.Lots {
+				productStock.addLot(transactionLot.Lot, sign*transactionLot.Quantity)
+			}
+			for _, serialNumber := range transaction.SerialNumbers {
+				productStock.serials[serialNumber.Serial] = sign > 0
+			}
+		}
+	}
+
+	return stock, nil
+}
+
+// 같은 로트 번호의 수량을 합산 ( 처음 출고된 로트의 제조일/유통기한 사용 )
+func (s *dispatchedProductStock) addLot(lot *models.Lot, quantity int) {
+	if lot == nil {
+		return
+	}
+
+	for i := range s.lots {
+		if s.lots[i].LotNumber == lot.LotNumber {
+			s.lots[i].Quantity += quantity
+			return
+		}
+	}
+
+	s.lots = append(s.lots, models.Lot{
+		LotNumber:      lot.LotNumber,
+		ManufacturedAt: lot.ManufacturedAt,
+		ExpiresAt:      lot.ExpiresAt,
+		Quantity:       quantity,
+	})
+}
+
+// 입고 요청을 출고된 로트/일련번호로 확인하고 입고(IN) 재고내역으로 나눔 ( 나눈 수량은 이후 항목에서 제외 )
+func (d dispatchedStock) receive(productID uint, receipt models.TransferOrderReceipt) ([]*models.Transaction, error) {
+	stock := d[productID]
+
+	for _, serial := range receipt.Serials {
+		if !stock.serials[serial] {
+			return nil, fmt.Errorf("출고되지 않았거나 이미 입고된 일련번호입니다 (serial: %s)", serial)
+		}
+	}
+
+	if len(stock.lots) == 0 {
+		if receipt.LotNumber != "" {
+			return nil, fmt.Errorf("출고되지 않은 로트입니다 (lot_number: %s)", receipt.LotNumber)
+		}
+
+		for _, serial := range receipt.Serials {
+			stock.serials[serial] = false
+		}
+		return []*models.Transaction{{Type: "IN", Quantity: receipt.Quantity, Serials: receipt.Serials}}, nil
+	}
+
+	// 로트 수량만큼 일련번호도 순서대로 나누어 입고
+	var ins []*models.Transaction
+	remaining, remainingSerials := receipt.Quantity, receipt.Serials
+	for i := range stock.lots {
+		lot := &stock.lots[i]
+		if remaining == 0 {
+			break
+		}
+		if lot.Quantity <= 0 || (receipt.LotNumber != "" && lot.LotNumber != receipt.LotNumber) {
+			continue
+		}
+
+		take := min(remaining, lot.Quantity)
+		var lotSerials []string
+		if len(remainingSerials) >= take {
+			lotSerials, remainingSerials = remainingSerials[:take], remainingSerials[take:]
+		}
+
+		ins = append(ins, &models.Transaction{
+			Type:           "IN",
+			Quantity:       take,
+			LotNumber:      lot.LotNumber,
+			ManufacturedAt: lot.ManufacturedAt,
+			ExpiresAt:      lot.ExpiresAt,
+			Serials:        lotSerials,
+		})
+		lot.Quantity -= take
+		remaining -= take
+	}
+
+	if remaining > 0 {
+		if receipt.LotNumber != "" {
+			return nil, fmt.Errorf("출고된 로트의 입고 가능 수량이 부족합니다 (lot_number: %s)", receipt.LotNumber)
+		}
+		return nil, errors.New("출고된 로트의 입고 가능 수량이 부족합니다")
+	}
+
+	for _, serial := range receipt.Serials {
+		stock.serials[serial] = false
+	}
+
+	return ins, nil
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/internal/repositories"
+	"github.com/jhphon0730/StockFlow/pkg/redis"
+
+	"gorm.io/gorm"
+
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// 영구 삭제 보관 기간 기본값 ( 이 기간이 지난 삭제 레코드만 영구 삭제 )
+const DefaultTrashRetentionDays = 30
+
+type TrashService interface {
+	FindAll(recordType string) (int, *models.Trash, error)
+	Restore(recordType string, id uint, ctx context.Context) (int, *models.TrashCount, error)
+	Purge(retentionDays int) (int, *models.TrashPurgeResult, error)
+}
+
+type trashService struct {
+	trashRepository     repositories.TrashRepository
+	warehouseRepository repositories.WarehouseRepository
+	productRepository   repositories.ProductRepository
+	inventoryRepository repositories.InventoryRepository
+}
+
+func NewTrashService(
+	trashRepository repositories.TrashRepository,
+	warehouseRepository repositories.WarehouseRepository,
+	productRepository repositories.ProductRepository,
+	inventoryRepository repositories.InventoryRepository,
+) TrashService {
+	return &trashService{
+		trashRepository:     trashRepository,
+		warehouseRepository: warehouseRepository,
+		productRepository:   productRepository,
+		inventoryRepository: inventoryRepository,
+	}
+}
+
+func checkTrashType(recordType string) error {
+	switch recordType {
+	case models.TRASH_TYPE_WAREHOUSES, models.TRASH_TYPE_PRODUCTS, models.TRASH_TYPE_INVENTORIES, models.TRASH_TYPE_TRANSACTIONS:
+		return nil
+	}
+
+	return errors.New("유형은 warehouses, products, inventories, transactions 중 하나여야 합니다")
+}
+
+// 삭제된 레코드 조회 ( 유형을 지정하지 않으면 전체 )
+func (s *trashService) FindAll(recordType string) (int, *models.Trash, error) {
+	if recordType != "" {
+		if err := checkTrashType(recordType); err != nil {
+			return http.StatusBadRequest, nil, err
+		}
+	}
+
+	trash, err := s.trashRepository.FindDeleted(recordType)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, trash, nil
+}
+
+// 삭제된 레코드 복원 ( 함께 삭제된 하위 레코드 포함 )
+// - 재고는 창고와 제품이 모두 복원되어 있어야 함
+// - 재고내역은 단독으로 복원하면 재고 수량과 맞지 않으므로, 함께 삭제된 재고를 복원
+func (s *trashService) Restore(recordType string, id uint, ctx context.Context) (int, *models.TrashCount, error) {
+	if err := checkTrashType(recordType); err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+
+	var count *models.TrashCount
+	var err error
+
+	switch recordType {
+	case models.TRASH_TYPE_WAREHOUSES:
+		warehouse, findErr := s.trashRepository.FindDeletedWarehouse(id)
+		if findErr != nil {
+			return trashNotFound(findErr)
+		}
+		count, err = s.trashRepository.RestoreWarehouse(warehouse)
+
+	case models.TRASH_TYPE_PRODUCTS:
+		product, findErr := s.trashRepository.FindDeletedProduct(id)
+		if findErr != nil {
+			return trashNotFound(findErr)
+		}
+		if product.ParentID != nil {
+			if _, err := s.productRepository.FindByID(*product.ParentID); err != nil {
+				return http.StatusConflict, nil, errors.New("상위 제품을 먼저 복원해야 합니다")
+			}
+		}
+		count, err = s.trashRepository.RestoreProduct(product)
+
+	case models.TRASH_TYPE_INVENTORIES:
+		inventory, findErr := s.trashRepository.FindDeletedInventory(id)
+		if findErr != nil {
+			return trashNotFound(findErr)
+		}
+		if status, err := s.checkInventoryRestorable(inventory); err != nil {
+			return status, nil, err
+		}
+		count, err = s.trashRepository.RestoreInventory(inventory)
+
+	case models.TRASH_TYPE_TRANSACTIONS:
+		transaction, findErr := s.trashRepository.FindDeletedTransaction(id)
+		if findErr != nil {
+			return trashNotFound(findErr)
+		}
+		inventory, findErr := s.trashRepository.FindDeletedInventory(transaction.InventoryID)
+		if findErr != nil {
+			return http.StatusConflict, nil, errors.New("재고가 삭제되지 않은 재고내역은 복원할 수 없습니다 ( 재고 수량과 맞지 않음 )")
+		}
+		if status, err := s.checkInventoryRestorable(inventory); err != nil {
+			return status, nil, err
+		}
+		count, err = s.trashRepository.RestoreInventory(inventory)
+	}
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+
+	return http.StatusOK, count, nil
+}
+
+// 재고 복원 가능 여부 ( 창고와 제품이 있고, 같은 창고/제품의 재고가 새로 생성되지 않은 경우 )
+func (s *trashService) checkInventoryRestorable(inventory *models.Inventory) (int, error) {
+	if _, err := s.warehouseRepository.FindByID(inventory.WarehouseID); err != nil {
+		return http.StatusConflict, errors.New("창고를 먼저 복원해야 합니다")
+	}
+
+	if _, err := s.productRepository.FindByID(inventory.ProductID); err != nil {
+		return http.StatusConflict, errors.New("제품을 먼저 복원해야 합니다")
+	}
+
+	if _, err := s.inventoryRepository.FindByWarehouseAndProduct(inventory.WarehouseID, inventory.ProductID); err == nil {
+		return http.StatusConflict, errors.New("같은 창고와 제품의 재고가 이미 있습니다")
+	}
+
+	return http.StatusOK, nil
+}
+
+func trashNotFound(err error) (int, *models.TrashCount, error) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return http.StatusNotFound, nil, errors.New("삭제된 레코드를 찾을 수 없습니다")
+	}
+
+	return http.StatusInternalServerError, nil, err
+}
+
+// 보관 기간이 지난 삭제 레코드 영구 삭제
+func (s *trashService) Purge(retentionDays int) (int, *models.TrashPurgeResult, error) {
+	if retentionDays < 0 {
+		return http.StatusBadRequest, nil, errors.New("보관 기간은 0일 이상이어야 합니다")
+	}
+
+	before := time.Now().AddDate(0, 0, -retentionDays)
+	result, err := s.trashRepository.Purge(before)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return http.StatusOK, result, nil
+}
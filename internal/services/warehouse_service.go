@@ -5,14 +5,18 @@ import (
 	"github.com/jhphon0730/StockFlow/internal/repositories"
 	"github.com/jhphon0730/StockFlow/pkg/redis"
 
+	"gorm.io/gorm"
+
 	"context"
+	"errors"
 	"net/http"
 )
 
 type WarehouseService interface {
-	FindAll(ctx context.Context, search_filter map[string]interface{}) (int, []models.Warehouse, error)
+	FindAll(ctx context.Context, search_filter map[string]interface{}, page models.PageQuery) (int, []models.Warehouse, *models.Pagination, error)
 	FindByID(id uint) (int, *models.Warehouse, error)
 	Create(warehouse *models.Warehouse, ctx context.Context) (int, *models.Warehouse, error)
+	Update(id uint, updates map[string]interface{}, ctx context.Context) (int, *models.Warehouse, error)
 	Delete(id uint, ctx context.Context) (int, error)
 }
 
@@ -35,26 +39,27 @@ func (w *warehouseService) getWarehouseRedis(ctx context.Context) redis.Warehous
 	return warehouseRedis
 }
 
-func (w *warehouseService) FindAll(ctx context.Context, search_filter map[string]interface{}) (int, []models.Warehouse, error) {
+// 검색 조건이 없는 목록은 페이지 조건별로 캐시
+func (w *warehouseService) FindAll(ctx context.Context, search_filter map[string]interface{}, page models.PageQuery) (int, []models.Warehouse, *models.Pagination, error) {
 	warehouseRedis := w.getWarehouseRedis(ctx)
 
 	if warehouseRedis != nil && len(search_filter) == 0 {
-		warehouses, err := warehouseRedis.GetWarehouseCache(ctx)
-		if err == nil && len(warehouses) > 0 { 
-			return http.StatusOK, warehouses, nil
+		warehouses, pagination, err := warehouseRedis.GetWarehouseCache(ctx, page)
+		if err == nil && pagination != nil {
+			return http.StatusOK, warehouses, pagination, nil
 		}
 	}
 
-	warehouses, err := w.warehouseRepository.FindAll(search_filter)
+	warehouses, pagination, err := w.warehouseRepository.FindPage(search_filter, page)
 	if err != nil {
-		return http.StatusInternalServerError, nil, err
+		return http.StatusInternalServerError, nil, nil, err
 	}
 
 	if warehouseRedis != nil && len(search_filter) == 0 {
-		_ = warehouseRedis.SetWarehouseCache(ctx, warehouses)
+		_ = warehouseRedis.SetWarehouseCache(ctx, page, warehouses, pagination)
 	}
 
-	return http.StatusOK, warehouses, nil
+	return http.StatusOK, warehouses, pagination, nil
 }
 
 func (w *warehouseService) FindByID(id uint) (int, *models.Warehouse, error) {
@@ -77,6 +82,24 @@ func (w *warehouseService) Create(warehouse *models.Warehouse, ctx context.Conte
 	return http.StatusCreated, createdWarehouse, nil
 }
 
+// 창고 정보 변경 ( 재고는 그대로 유지 )
+func (w *warehouseService) Update(id uint, updates map[string]interface{}, ctx context.Context) (int, *models.Warehouse, error) {
+	if _, err := w.warehouseRepository.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusNotFound, nil, errors.New("존재하지 않는 창고입니다")
+		}
+		return http.StatusInternalServerError, nil, err
+	}
+
+	if err := w.warehouseRepository.Update(id, updates); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	redis.RestoreRedisData(ctx)
+
+	return w.FindByID(id)
+}
+
 func (w *warehouseService) Delete(id uint, ctx context.Context) (int, error) {
 	err := w.warehouseRepository.Delete(id)
 	if err != nil {
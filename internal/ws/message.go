@@ -72,3 +72,14 @@ func (w *webSocketManager) broadcastingWithSender(msg Message) {
 	}
 	room.Mutex.Unlock()
 }
+
+// 서버에서 발생한 이벤트를 Room 의 모든 클라이언트에게 전송
+func (w *webSocketManager) Broadcast(roomID string, action string, data interface{}) {
+	msg := Message{
+		Action: action,
+		RoomID: roomID,
+		ClientID: "server",
+		Data: data,
+	}
+	w.broadcastingWithSender(msg)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/websocket"
 
+	"fmt"
 	"log"
 	"sync"
 )
@@ -19,6 +20,7 @@ type Client struct {
 type WebSocketManager interface {
 	HandleConnection(conn *websocket.Conn, roomID string, clientID string)
 	GetRoomClientCount() []models.RoomInfo
+	Broadcast(roomID string, action string, data interface{})
 }
 
 type webSocketManager struct {
@@ -41,6 +43,11 @@ var (
 	wsManager = NewWebSocketManager()
 )
 
+// 창고 상세 화면의 Room ID
+func WarehouseRoomID(warehouseID uint) string {
+	return fmt.Sprintf("warehouses/%d", warehouseID)
+}
+
 func (w *webSocketManager) HandleConnection(conn *websocket.Conn, roomID string, clientID string) {
 	client := &Client{
 		ID: clientID,
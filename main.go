@@ -39,8 +39,18 @@ func main() {
 		log.Fatalln("Database Migration Error:", err)
 	}
 
+	// 재고내역 정합성 검사 명령 ( 서버를 실행하지 않고 종료 )
+	if len(os.Args) > 1 && os.Args[1] == "check-ledger" {
+		if err := server.RunLedgerCheck(ctx, os.Args[2:]); err != nil {
+			log.Fatalln("Ledger Check Error:", err)
+		}
+		cancel()
+		return
+	}
+
 	s := server.NewServer()
 	s.Init("8080")
+	s.StartBackgroundJobs(ctx)
 
 	// OS 종료 신호 감지
 	c := make(chan os.Signal, 1)
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+	"github.com/jhphon0730/StockFlow/pkg/utils"
+
+	"errors"
+)
+
+type CreateBarcodeDTO struct {
+	ProductID uint   `json:"product_id"`
+	Code      string `json:"code"`
+	Symbology string `json:"symbology"` // EAN13, EAN8, UPCA, CODE128 ( 미지정 시 CODE128 )
+	Unit      string `json:"unit"`      // 바코드가 가리키는 단위 ( 미지정 시 기본 단위 )
+}
+
+func (c *CreateBarcodeDTO) CheckCreateBarcodeDTO() (bool, error) {
+	if c.ProductID == 0 {
+		return false, errors.New("제품 ID는 필수 입력 사항입니다")
+	}
+
+	if c.Code == "" {
+		return false, errors.New("바코드는 필수 입력 사항입니다")
+	}
+
+	switch c.Symbology {
+	case models.BARCODE_EAN13:
+		if !utils.IsValidGTIN(c.Code, 13) {
+			return false, errors.New("올바른 EAN-13 바코드가 아닙니다")
+		}
+	case models.BARCODE_EAN8:
+		if !utils.IsValidGTIN(c.Code, 8) {
+			return false, errors.New("올바른 EAN-8 바코드가 아닙니다")
+		}
+	case models.BARCODE_UPCA:
+		if !utils.IsValidGTIN(c.Code, 12) {
+			return false, errors.New("올바른 UPC-A 바코드가 아닙니다")
+		}
+	case "", models.BARCODE_CODE128:
+		if !utils.IsValidCode128(c.Code) {
+			return false, errors.New("올바른 Code128 바코드가 아닙니다")
+		}
+	default:
+		return false, errors.New("지원하지 않는 바코드 유형입니다")
+	}
+
+	return true, nil
+}
+
+func (c *CreateBarcodeDTO) ToModel() *models.Barcode {
+	symbology := c.Symbology
+	if symbology == "" {
+		symbology = models.BARCODE_CODE128
+	}
+
+	return &models.Barcode{
+		ProductID: c.ProductID,
+		Code:      c.Code,
+		Symbology: symbology,
+		Unit:      c.Unit,
+	}
+}
@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"errors"
+)
+
+type CreateBinLocationDTO struct {
+	WarehouseID uint   `json:"warehouse_id"`
+	Zone        string `json:"zone"`  // 구역
+	Aisle       string `json:"aisle"` // 통로
+	Shelf       string `json:"shelf"` // 선반
+	Bin         string `json:"bin"`   // 칸
+}
+
+func (c *CreateBinLocationDTO) CheckCreateBinLocationDTO() (bool, error) {
+	if c.WarehouseID == 0 {
+		return false, errors.New("창고 ID는 필수 입력 사항입니다")
+	}
+
+	if c.Zone == "" {
+		return false, errors.New("구역은 필수 입력 사항입니다")
+	}
+
+	// 상위 단계 없이 하위 단계만 지정할 수 없음
+	if (c.Shelf != "" && c.Aisle == "") || (c.Bin != "" && c.Shelf == "") {
+		return false, errors.New("보관 위치는 구역 > 통로 > 선반 > 칸 순서로 입력해야 합니다")
+	}
+
+	return true, nil
+}
+
+func (c *CreateBinLocationDTO) ToModel() *models.BinLocation {
+	return &models.BinLocation{
+		WarehouseID: c.WarehouseID,
+		Zone:        c.Zone,
+		Aisle:       c.Aisle,
+		Shelf:       c.Shelf,
+		Bin:         c.Bin,
+	}
+}
+
+type MoveBinStockDTO struct {
+	InventoryID       uint `json:"inventory_id"`
+	FromBinLocationID uint `json:"from_bin_location_id"`
+	ToBinLocationID   uint `json:"to_bin_location_id"`
+	Quantity          int  `json:"quantity"`
+}
+
+func (m *MoveBinStockDTO) CheckMoveBinStockDTO() (bool, error) {
+	if m.InventoryID == 0 {
+		return false, errors.New("Inventory ID는 필수 입력 사항입니다")
+	}
+
+	if m.FromBinLocationID == 0 || m.ToBinLocationID == 0 {
+		return false, errors.New("출발/도착 보관 위치 ID는 필수 입력 사항입니다")
+	}
+
+	if m.FromBinLocationID == m.ToBinLocationID {
+		return false, errors.New("출발 보관 위치와 도착 보관 위치가 같을 수 없습니다")
+	}
+
+	if m.Quantity <= 0 {
+		return false, errors.New("이동 수량은 1개 이상이어야 합니다")
+	}
+
+	return true, nil
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"errors"
+)
+
+type CreateCategoryDTO struct {
+	Name     string `json:"name"`
+	ParentID *uint  `json:"parent_id"` // 상위 분류 ( 미지정 시 최상위 분류 )
+}
+
+func (c *CreateCategoryDTO) CheckCreateCategoryDTO() (bool, error) {
+	if c.Name == "" {
+		return false, errors.New("분류 이름은 필수 입력 사항입니다")
+	}
+
+	return true, nil
+}
+
+func (c *CreateCategoryDTO) ToModel() *models.Category {
+	return &models.Category{
+		Name:     c.Name,
+		ParentID: c.ParentID,
+	}
+}
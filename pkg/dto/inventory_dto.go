@@ -35,3 +35,74 @@ func (c *CreateInventoryDTO) ToModel() *models.Inventory {
 		Quantity:    c.Quantity,
 	}
 }
+
+type UpdateInventoryThresholdsDTO struct {
+	MinQuantity  int `json:"min_quantity"`  // 최소 수량 ( 0 은 미설정 )
+	ReorderPoint int `json:"reorder_point"` // 재주문점 ( 0 은 미설정 )
+	MaxQuantity  int `json:"max_quantity"`  // 최대 수량 ( 0 은 미설정 )
+}
+
+func (u *UpdateInventoryThresholdsDTO) CheckUpdateInventoryThresholdsDTO() (bool, error) {
+	if u.MinQuantity < 0 || u.ReorderPoint < 0 || u.MaxQuantity < 0 {
+		return false, errors.New("임계치는 0 이상이어야 합니다")
+	}
+
+	if u.MinQuantity > 0 && u.ReorderPoint > 0 && u.MinQuantity > u.ReorderPoint {
+		return false, errors.New("최소 수량은 재주문점보다 클 수 없습니다")
+	}
+
+	if u.MaxQuantity > 0 && (u.MinQuantity > u.MaxQuantity || u.ReorderPoint > u.MaxQuantity) {
+		return false, errors.New("최대 수량은 최소 수량과 재주문점보다 커야 합니다")
+	}
+
+	return true, nil
+}
+
+// 재고 수정 ( PATCH: 입력한 임계치만 변경, PUT: 입력하지 않은 임계치는 미설정(0) )
+// - 수량은 재고내역으로만, 창고/제품은 재고 이동으로만 변경
+type UpdateInventoryDTO struct {
+	MinQuantity  *int `json:"min_quantity"`
+	ReorderPoint *int `json:"reorder_point"`
+	MaxQuantity  *int `json:"max_quantity"`
+
+	// 변경할 수 없는 항목 ( 입력 시 오류 )
+	Quantity    *int  `json:"quantity"`
+	WarehouseID *uint `json:"warehouse_id"`
+	ProductID   *uint `json:"product_id"`
+}
+
+func (u *UpdateInventoryDTO) CheckUpdateInventoryDTO() (bool, error) {
+	if u.Quantity != nil {
+		return false, errors.New("수량은 재고내역으로만 변경할 수 있습니다")
+	}
+
+	if u.WarehouseID != nil || u.ProductID != nil {
+		return false, errors.New("창고와 제품은 변경할 수 없습니다 ( 재고 이동을 사용하세요 )")
+	}
+
+	for _, threshold := range []*int{u.MinQuantity, u.ReorderPoint, u.MaxQuantity} {
+		if threshold != nil && *threshold < 0 {
+			return false, errors.New("임계치는 0 이상이어야 합니다")
+		}
+	}
+
+	return true, nil
+}
+
+// 변경할 임계치 컬럼과 값 ( replace 인 경우 입력하지 않은 임계치는 0 )
+func (u *UpdateInventoryDTO) ToThresholds(replace bool) map[string]int {
+	thresholds := make(map[string]int, 3)
+	for column, value := range map[string]*int{
+		"min_quantity":  u.MinQuantity,
+		"reorder_point": u.ReorderPoint,
+		"max_quantity":  u.MaxQuantity,
+	} {
+		if value != nil {
+			thresholds[column] = *value
+		} else if replace {
+			thresholds[column] = 0
+		}
+	}
+
+	return thresholds
+}
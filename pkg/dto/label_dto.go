@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"github.com/jhphon0730/StockFlow/pkg/label"
+
+	"errors"
+)
+
+type CreateLabelSheetDTO struct {
+	WarehouseID *uint  `json:"warehouse_id"` // 창고의 재고(또는 보관 위치) 라벨 출력
+	ProductIDs  []uint `json:"product_ids"`  // 제품 라벨 출력 ( 창고 지정 시 해당 제품의 재고만 )
+	Bins        bool   `json:"bins"`         // 창고의 보관 위치 라벨 출력
+	Type        string `json:"type"`         // CODE128, QR ( 미지정 시 CODE128 )
+}
+
+func (c *CreateLabelSheetDTO) CheckCreateLabelSheetDTO() (bool, error) {
+	if c.WarehouseID == nil && len(c.ProductIDs) == 0 {
+		return false, errors.New("창고 ID 또는 제품 ID 목록은 필수 입력 사항입니다")
+	}
+
+	if c.Bins && c.WarehouseID == nil {
+		return false, errors.New("보관 위치 라벨은 창고 ID가 필요합니다")
+	}
+
+	if c.Type != "" && c.Type != label.TYPE_CODE128 && c.Type != label.TYPE_QR {
+		return false, errors.New("라벨 유형은 CODE128 또는 QR 이어야 합니다")
+	}
+
+	return true, nil
+}
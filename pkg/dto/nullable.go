@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"encoding/json"
+)
+
+// 요청에서 생략한 값과 null 을 구분하는 ID ( PATCH 에서 null 로 연결을 해제할 때 사용 )
+type NullableID struct {
+	Set   bool  // 요청에 포함된 경우 true ( null 포함 )
+	Value *uint // null 이면 nil
+}
+
+func (n *NullableID) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	n.Value = nil
+
+	if string(data) == "null" {
+		return nil
+	}
+
+	var value uint
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Value = &value
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package dto
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"errors"
+)
+
+type CreateOrderItemDTO struct {
+	ProductID uint `json:"product_id"`
+	Quantity  int  `json:"quantity"`
+}
+
+type CreateOrderDTO struct {
+	WarehouseID uint                 `json:"warehouse_id"`
+	Customer    string               `json:"customer"`
+	Note        string               `json:"note"`
+	Items       []CreateOrderItemDTO `json:"items"`
+}
+
+func (c *CreateOrderDTO) CheckCreateOrderDTO() (bool, error) {
+	if c.WarehouseID == 0 {
+		return false, errors.New("창고 ID는 필수 입력 사항입니다")
+	}
+
+	if c.Customer == "" {
+		return false, errors.New("주문자는 필수 입력 사항입니다")
+	}
+
+	if len(c.Items) == 0 {
+		return false, errors.New("주문 항목은 최소 1개 이상이어야 합니다")
+	}
+
+	products := make(map[uint]bool)
+	for _, item := range c.Items {
+		if item.ProductID == 0 {
+			return false, errors.New("제품 ID는 필수 입력 사항입니다")
+		}
+
+		if item.Quantity <= 0 {
+			return false, errors.New("주문 수량은 1개 이상이어야 합니다")
+		}
+
+		if products[item.ProductID] {
+			return false, errors.New("같은 제품을 중복으로 주문할 수 없습니다")
+		}
+		products[item.ProductID] = true
+	}
+
+	return true, nil
+}
+
+func (c *CreateOrderDTO) ToModel(userID uint) *models.Order {
+	orderItems := make([]models.OrderItem, 0, len(c.Items))
+	for _, item := range c.Items {
+		orderItems = append(orderItems, models.OrderItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	return &models.Order{
+		UserID:      userID,
+		WarehouseID: c.WarehouseID,
+		Customer:    c.Customer,
+		Note:        c.Note,
+		Status:      models.ORDER_STATUS_DRAFT,
+		OrderItems:  orderItems,
+	}
+}
+
+type ShipOrderItemDTO struct {
+	ItemID  uint     `json:"item_id"`
+	Serials []string `json:"serials"` // 일련번호 관리 제품 필수 ( 수량만큼 )
+}
+
+type UpdateOrderStatusDTO struct {
+	Status string             `json:"status"`
+	Items  []ShipOrderItemDTO `json:"items"` // 출고 완료(SHIPPED) 시 항목별 출고 일련번호
+}
+
+func (u *UpdateOrderStatusDTO) CheckUpdateOrderStatusDTO() (bool, error) {
+	switch u.Status {
+	case models.ORDER_STATUS_APPROVED, models.ORDER_STATUS_PICKING, models.ORDER_STATUS_SHIPPED, models.ORDER_STATUS_CANCELLED:
+	case "":
+		return false, errors.New("Status는 필수 입력 사항입니다")
+	default:
+		return false, errors.New("올바르지 않은 주문 상태입니다")
+	}
+
+	if len(u.Items) > 0 && u.Status != models.ORDER_STATUS_SHIPPED {
+		return false, errors.New("일련번호는 출고 완료(SHIPPED) 시에만 입력할 수 있습니다")
+	}
+
+	items := make(map[uint]bool)
+	for _, item := range u.Items {
+		if item.ItemID == 0 {
+			return false, errors.New("항목 ID는 필수 입력 사항입니다")
+		}
+
+		if items[item.ItemID] {
+			return false, errors.New("같은 항목을 중복으로 입력할 수 없습니다")
+		}
+		items[item.ItemID] = true
+
+		if ok, err := checkSerials(item.Serials); !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// 주문 항목 ID -> 출고 일련번호
+func (u *UpdateOrderStatusDTO) ToSerials() map[uint][]string {
+	serials := make(map[uint][]string, len(u.Items))
+	for _, item := range u.Items {
+		serials[item.ItemID] = item.Serials
+	}
+
+	return serials
+}
@@ -6,10 +6,31 @@ import (
 	"errors"
 )
 
+type ProductUnitDTO struct {
+	Name   string `json:"name"`   // 단위 이름 ( 예: INNER, CASE, PALLET )
+	Factor int    `json:"factor"` // 1 단위에 해당하는 기본 단위 수량
+}
+
+type ProductAttributeDTO struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"` // STRING, NUMBER, BOOLEAN ( 기본값 STRING )
+	Value string `json:"value"`
+}
+
 type CreateProductDTO struct {
 	Name        string `json:"name" binding:"required"`
 	Description string `json:"description"`
 	SKU         string `json:"sku" binding:"required"`
+	LotTracked  bool   `json:"lot_tracked"` // 로트/유통기한 관리 여부
+	Serialized  bool   `json:"serialized"`  // 일련번호 관리 여부
+	CostMethod  string `json:"cost_method"` // 출고 원가 계산 방식 ( FIFO, AVERAGE / 기본값 FIFO )
+	BaseUnit    string `json:"base_unit"`   // 기본 단위 ( 기본값 EA )
+
+	Units []ProductUnitDTO `json:"units"` // 기본 단위 외 환산 단위
+
+	CategoryID *uint                 `json:"category_id"` // 분류 ( 선택 )
+	ParentID   *uint                 `json:"parent_id"`   // 상위 제품 ( 변형 제품인 경우 )
+	Attributes []ProductAttributeDTO `json:"attributes"`  // 사용자 정의 속성 ( 예: color, size )
 }
 
 func (c *CreateProductDTO) CheckCreateProductDTO() (bool, error) {
@@ -21,6 +42,23 @@ func (c *CreateProductDTO) CheckCreateProductDTO() (bool, error) {
 		return false, errors.New("SKU는 필수 입력 사항입니다")
 	}
 
+	if c.CostMethod != "" && c.CostMethod != models.COST_METHOD_FIFO && c.CostMethod != models.COST_METHOD_AVERAGE {
+		return false, errors.New("원가 계산 방식은 FIFO 또는 AVERAGE 이어야 합니다")
+	}
+
+	baseUnit := c.BaseUnit
+	if baseUnit == "" {
+		baseUnit = models.UNIT_EACH
+	}
+
+	if ok, err := checkProductUnits(baseUnit, c.Units); !ok {
+		return false, err
+	}
+
+	if ok, err := checkProductAttributes(c.Attributes); !ok {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -29,5 +67,166 @@ func (c *CreateProductDTO) ToModel() *models.Product {
 		Name:        c.Name,
 		Description: c.Description,
 		SKU:         c.SKU,
+		LotTracked:  c.LotTracked,
+		Serialized:  c.Serialized,
+		CostMethod:  c.CostMethod,
+		BaseUnit:    c.BaseUnit,
+		Units:       toProductUnits(c.Units),
+		CategoryID:  c.CategoryID,
+		ParentID:    c.ParentID,
+		Attributes:  toProductAttributes(c.Attributes),
 	}
 }
+
+type UpdateProductUnitsDTO struct {
+	Units []ProductUnitDTO `json:"units"` // 전체 환산 단위 ( 기존 환산 단위를 대체 )
+}
+
+// 기본 단위와의 중복은 제품 조회 후 Service 에서 확인
+func (u *UpdateProductUnitsDTO) CheckUpdateProductUnitsDTO() (bool, error) {
+	return checkProductUnits("", u.Units)
+}
+
+func (u *UpdateProductUnitsDTO) ToModel() []models.ProductUnit {
+	return toProductUnits(u.Units)
+}
+
+// 환산 단위의 이름/환산 수량/중복 확인
+func checkProductUnits(baseUnit string, units []ProductUnitDTO) (bool, error) {
+	names := make(map[string]bool, len(units))
+	for _, unit := range units {
+		if unit.Name == "" {
+			return false, errors.New("단위 이름은 필수 입력 사항입니다")
+		}
+
+		if unit.Name == baseUnit {
+			return false, errors.New("기본 단위는 환산 단위로 등록할 수 없습니다")
+		}
+
+		if unit.Factor <= 0 {
+			return false, errors.New("환산 수량은 1 이상이어야 합니다")
+		}
+
+		if names[unit.Name] {
+			return false, errors.New("중복된 단위가 있습니다 (" + unit.Name + ")")
+		}
+		names[unit.Name] = true
+	}
+
+	return true, nil
+}
+
+func toProductUnits(units []ProductUnitDTO) []models.ProductUnit {
+	productUnits := make([]models.ProductUnit, 0, len(units))
+	for _, unit := range units {
+		productUnits = append(productUnits, models.ProductUnit{
+			Name:   unit.Name,
+			Factor: unit.Factor,
+		})
+	}
+
+	return productUnits
+}
+
+type UpdateProductAttributesDTO struct {
+	Attributes []ProductAttributeDTO `json:"attributes"` // 전체 속성 ( 기존 속성을 대체 )
+}
+
+func (u *UpdateProductAttributesDTO) CheckUpdateProductAttributesDTO() (bool, error) {
+	return checkProductAttributes(u.Attributes)
+}
+
+func (u *UpdateProductAttributesDTO) ToModel() []models.ProductAttribute {
+	return toProductAttributes(u.Attributes)
+}
+
+// 속성의 이름/중복 및 유형에 맞는 값인지 확인
+func checkProductAttributes(attributes []ProductAttributeDTO) (bool, error) {
+	names := make(map[string]bool, len(attributes))
+	for _, attribute := range toProductAttributes(attributes) {
+		if attribute.Name == "" {
+			return false, errors.New("속성 이름은 필수 입력 사항입니다")
+		}
+
+		if names[attribute.Name] {
+			return false, errors.New("중복된 속성이 있습니다 (" + attribute.Name + ")")
+		}
+		names[attribute.Name] = true
+
+		if err := attribute.CheckValue(); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func toProductAttributes(attributes []ProductAttributeDTO) []models.ProductAttribute {
+	productAttributes := make([]models.ProductAttribute, 0, len(attributes))
+	for _, attribute := range attributes {
+		attributeType := attribute.Type
+		if attributeType == "" {
+			attributeType = models.ATTRIBUTE_TYPE_STRING
+		}
+
+		productAttributes = append(productAttributes, models.ProductAttribute{
+			Name:  attribute.Name,
+			Type:  attributeType,
+			Value: attribute.Value,
+		})
+	}
+
+	return productAttributes
+}
+
+// 제품 수정 ( PATCH: 입력한 항목만 변경, PUT: 필수 항목을 모두 입력하고 나머지는 비움 )
+// - 로트/일련번호 관리 여부, 원가 계산 방식, 기본 단위는 재고내역에 영향을 주므로 변경 불가
+// - 환산 단위와 속성은 /units, /attributes 로 변경
+type UpdateProductDTO struct {
+	Name        *string    `json:"name"`
+	Description *string    `json:"description"`
+	SKU         *string    `json:"sku"`
+	CategoryID  NullableID `json:"category_id"` // null 이면 분류 해제
+	ParentID    NullableID `json:"parent_id"`   // null 이면 상위 제품 해제
+}
+
+func (u *UpdateProductDTO) CheckUpdateProductDTO(replace bool) (bool, error) {
+	if replace && (u.Name == nil || u.SKU == nil) {
+		return false, errors.New("제품 이름과 SKU는 필수 입력 사항입니다")
+	}
+
+	if u.Name != nil && *u.Name == "" {
+		return false, errors.New("제품 이름은 빈 값일 수 없습니다")
+	}
+
+	if u.SKU != nil && *u.SKU == "" {
+		return false, errors.New("SKU는 빈 값일 수 없습니다")
+	}
+
+	return true, nil
+}
+
+// 변경할 컬럼과 값
+func (u *UpdateProductDTO) ToUpdates(replace bool) map[string]interface{} {
+	updates := make(map[string]interface{})
+	if u.Name != nil {
+		updates["name"] = *u.Name
+	}
+	if u.SKU != nil {
+		updates["sku"] = *u.SKU
+	}
+	if u.Description != nil || replace {
+		updates["description"] = ""
+		if u.Description != nil {
+			updates["description"] = *u.Description
+		}
+	}
+	if u.CategoryID.Set || replace {
+		updates["category_id"] = u.CategoryID.Value
+	}
+	if u.ParentID.Set || replace {
+		updates["parent_id"] = u.ParentID.Value
+	}
+
+	return updates
+}
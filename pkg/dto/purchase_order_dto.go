@@ -0,0 +1,140 @@
+package dto
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"errors"
+	"time"
+)
+
+type CreatePurchaseOrderLineDTO struct {
+	ProductID       uint    `json:"product_id"`
+	OrderedQuantity int     `json:"ordered_quantity"`
+	UnitCost        float64 `json:"unit_cost"` // 매입 단가
+}
+
+// 발주 생성 및 작성 중인 발주 수정에 공통으로 사용
+type CreatePurchaseOrderDTO struct {
+	SupplierID  uint                         `json:"supplier_id"`
+	WarehouseID uint                         `json:"warehouse_id"`
+	Note        string                       `json:"note"`
+	ExpectedAt  *time.Time                   `json:"expected_at"`
+	Lines       []CreatePurchaseOrderLineDTO `json:"lines"`
+}
+
+func (c *CreatePurchaseOrderDTO) CheckCreatePurchaseOrderDTO() (bool, error) {
+	if c.SupplierID == 0 {
+		return false, errors.New("공급업체 ID는 필수 입력 사항입니다")
+	}
+
+	if c.WarehouseID == 0 {
+		return false, errors.New("입고 창고 ID는 필수 입력 사항입니다")
+	}
+
+	if len(c.Lines) == 0 {
+		return false, errors.New("발주 항목은 최소 1개 이상이어야 합니다")
+	}
+
+	products := make(map[uint]bool)
+	for _, line := range c.Lines {
+		if line.ProductID == 0 {
+			return false, errors.New("제품 ID는 필수 입력 사항입니다")
+		}
+
+		if line.OrderedQuantity <= 0 {
+			return false, errors.New("발주 수량은 1개 이상이어야 합니다")
+		}
+
+		if line.UnitCost < 0 {
+			return false, errors.New("매입 단가는 0 이상이어야 합니다")
+		}
+
+		if products[line.ProductID] {
+			return false, errors.New("같은 제품을 중복으로 등록할 수 없습니다")
+		}
+		products[line.ProductID] = true
+	}
+
+	return true, nil
+}
+
+func (c *CreatePurchaseOrderDTO) ToModel(userID uint) *models.PurchaseOrder {
+	lines := make([]models.PurchaseOrderLine, 0, len(c.Lines))
+	for _, line := range c.Lines {
+		lines = append(lines, models.PurchaseOrderLine{
+			ProductID:       line.ProductID,
+			OrderedQuantity: line.OrderedQuantity,
+			UnitCost:        line.UnitCost,
+		})
+	}
+
+	return &models.PurchaseOrder{
+		UserID:      userID,
+		SupplierID:  c.SupplierID,
+		WarehouseID: c.WarehouseID,
+		Note:        c.Note,
+		ExpectedAt:  c.ExpectedAt,
+		Status:      models.PURCHASE_STATUS_DRAFT,
+		Lines:       lines,
+	}
+}
+
+type ReceivePurchaseOrderLineDTO struct {
+	LineID         uint       `json:"line_id"`
+	Quantity       int        `json:"quantity"`
+	LotNumber      string     `json:"lot_number"` // 로트 관리 제품 필수
+	ManufacturedAt *time.Time `json:"manufactured_at"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+	Serials        []string   `json:"serials"`         // 일련번호 관리 제품 필수
+	BinLocationID  *uint      `json:"bin_location_id"` // 입고 보관 위치 ( 선택 )
+}
+
+type ReceivePurchaseOrderDTO struct {
+	Lines []ReceivePurchaseOrderLineDTO `json:"lines"`
+}
+
+func (r *ReceivePurchaseOrderDTO) CheckReceivePurchaseOrderDTO() (bool, error) {
+	if len(r.Lines) == 0 {
+		return false, errors.New("입고 항목은 최소 1개 이상이어야 합니다")
+	}
+
+	lines := make(map[uint]bool)
+	for _, line := range r.Lines {
+		if line.LineID == 0 {
+			return false, errors.New("항목 ID는 필수 입력 사항입니다")
+		}
+
+		if line.Quantity <= 0 {
+			return false, errors.New("입고 수량은 1개 이상이어야 합니다")
+		}
+
+		if lines[line.LineID] {
+			return false, errors.New("같은 항목을 중복으로 입고할 수 없습니다")
+		}
+		lines[line.LineID] = true
+
+		if ok, err := checkSerials(line.Serials); !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// 항목 ID -> 입고 요청
+func (r *ReceivePurchaseOrderDTO) ToReceipts() map[uint]models.PurchaseOrderReceipt {
+	receipts := make(map[uint]models.PurchaseOrderReceipt, len(r.Lines))
+	for _, line := range r.Lines {
+		receipts[line.LineID] = models.PurchaseOrderReceipt{
+			LineID:         line.LineID,
+			Quantity:       line.Quantity,
+			LotNumber:      line.LotNumber,
+			ManufacturedAt: line.ManufacturedAt,
+			ExpiresAt:      line.ExpiresAt,
+			Serials:        line.Serials,
+			BinLocationID:  line.BinLocationID,
+		}
+	}
+
+	return receipts
+}
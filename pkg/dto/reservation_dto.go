@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"errors"
+	"time"
+)
+
+type CreateReservationDTO struct {
+	InventoryID      uint   `json:"inventory_id"`
+	Quantity         int    `json:"quantity"`
+	OwnerReference   string `json:"owner_reference"`
+	ExpiresInMinutes int    `json:"expires_in_minutes"` // 예약 유지 시간 ( 분 )
+}
+
+func (c *CreateReservationDTO) CheckCreateReservationDTO() (bool, error) {
+	if c.InventoryID == 0 {
+		return false, errors.New("Inventory ID는 필수 입력 사항입니다")
+	}
+
+	if c.Quantity <= 0 {
+		return false, errors.New("예약 수량은 1개 이상이어야 합니다")
+	}
+
+	if c.OwnerReference == "" {
+		return false, errors.New("예약 주체는 필수 입력 사항입니다")
+	}
+
+	if c.ExpiresInMinutes <= 0 {
+		return false, errors.New("예약 유지 시간은 1분 이상이어야 합니다")
+	}
+
+	return true, nil
+}
+
+func (c *CreateReservationDTO) ToModel() *models.Reservation {
+	return &models.Reservation{
+		InventoryID:    c.InventoryID,
+		Quantity:       c.Quantity,
+		OwnerReference: c.OwnerReference,
+		Status:         models.RESERVATION_STATUS_ACTIVE,
+		ExpiresAt:      models.GetNowTime().Add(time.Duration(c.ExpiresInMinutes) * time.Minute),
+	}
+}
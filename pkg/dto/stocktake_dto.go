@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"errors"
+)
+
+type CreateStocktakeDTO struct {
+	WarehouseID   uint   `json:"warehouse_id"`
+	BinCodePrefix string `json:"bin_code_prefix"` // 보관 위치 코드 접두어 ( 예: A-01, 비어 있으면 창고 전체 )
+	Note          string `json:"note"`
+}
+
+func (c *CreateStocktakeDTO) CheckCreateStocktakeDTO() (bool, error) {
+	if c.WarehouseID == 0 {
+		return false, errors.New("창고 ID는 필수 입력 사항입니다")
+	}
+
+	return true, nil
+}
+
+func (c *CreateStocktakeDTO) ToModel(userID uint) *models.Stocktake {
+	return &models.Stocktake{
+		UserID:        userID,
+		WarehouseID:   c.WarehouseID,
+		BinCodePrefix: c.BinCodePrefix,
+		Note:          c.Note,
+		Status:        models.STOCKTAKE_STATUS_OPEN,
+	}
+}
+
+type SubmitStocktakeCountDTO struct {
+	LineID          uint `json:"line_id"`
+	CountedQuantity *int `json:"counted_quantity"`
+}
+
+type SubmitStocktakeCountsDTO struct {
+	Lines []SubmitStocktakeCountDTO `json:"lines"`
+}
+
+func (s *SubmitStocktakeCountsDTO) CheckSubmitStocktakeCountsDTO() (bool, error) {
+	if len(s.Lines) == 0 {
+		return false, errors.New("실사 항목은 최소 1개 이상이어야 합니다")
+	}
+
+	lines := make(map[uint]bool)
+	for _, line := range s.Lines {
+		if line.LineID == 0 {
+			return false, errors.New("항목 ID는 필수 입력 사항입니다")
+		}
+
+		if line.CountedQuantity == nil {
+			return false, errors.New("실사 수량은 필수 입력 사항입니다")
+		}
+
+		if *line.CountedQuantity < 0 {
+			return false, errors.New("실사 수량은 0 이상이어야 합니다")
+		}
+
+		if lines[line.LineID] {
+			return false, errors.New("같은 항목을 중복으로 입력할 수 없습니다")
+		}
+		lines[line.LineID] = true
+	}
+
+	return true, nil
+}
+
+// 항목 ID -> 실사 수량
+func (s *SubmitStocktakeCountsDTO) ToCounts() map[uint]int {
+	counts := make(map[uint]int, len(s.Lines))
+	for _, line := range s.Lines {
+		counts[line.LineID] = *line.CountedQuantity
+	}
+
+	return counts
+}
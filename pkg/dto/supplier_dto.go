@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"errors"
+	"strings"
+)
+
+// 공급업체 생성 및 수정에 공통으로 사용
+type CreateSupplierDTO struct {
+	Name        string `json:"name"`
+	ContactName string `json:"contact_name"`
+	Email       string `json:"email"`
+	Phone       string `json:"phone"`
+	Address     string `json:"address"`
+	Note        string `json:"note"`
+}
+
+func (c *CreateSupplierDTO) CheckCreateSupplierDTO() (bool, error) {
+	if c.Name == "" {
+		return false, errors.New("공급업체 이름은 필수 입력 사항입니다")
+	}
+
+	if c.Email != "" && !strings.Contains(c.Email, "@") {
+		return false, errors.New("이메일 형식이 올바르지 않습니다")
+	}
+
+	return true, nil
+}
+
+func (c *CreateSupplierDTO) ToModel() *models.Supplier {
+	return &models.Supplier{
+		Name:        c.Name,
+		ContactName: c.ContactName,
+		Email:       c.Email,
+		Phone:       c.Phone,
+		Address:     c.Address,
+		Note:        c.Note,
+	}
+}
@@ -4,12 +4,27 @@ import (
 	"github.com/jhphon0730/StockFlow/internal/models"
 
 	"errors"
+	"fmt"
+	"time"
 )
 
 type CreateTransactionDTO struct {
 	InventoryID uint   `json:"inventory_id"`
 	Type        string `json:"type"`
 	Quantity    int    `json:"quantity"` // Inventory의 Quantity를 변경할 때 사용 ( 기존 값도 받을 수 있도록 )
+	Unit        string `json:"unit"`     // 수량/단가의 단위 ( 미지정 시 제품 기본 단위, 저장 시 기본 단위로 환산 )
+
+	BinLocationID *uint `json:"bin_location_id"` // 입고/출고 보관 위치 ( 선택 )
+
+	UnitCost float64 `json:"unit_cost"` // 입고(IN)/조정(ADJUST) 증가분 단가 ( 출고 단가는 원가층에서 계산 )
+
+	// 로트 관리 제품 입고(IN)/조정(ADJUST) 시 사용
+	LotNumber      string     `json:"lot_number"`
+	ManufacturedAt *time.Time `json:"manufactured_at"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+
+	// 일련번호 관리 제품 입고(IN)/출고(OUT) 시 사용 ( 수량만큼 필수 )
+	Serials []string `json:"serials"`
 }
 
 func (c *CreateTransactionDTO) CheckCreateInventoryDTO() (bool, error) {
@@ -25,14 +40,144 @@ func (c *CreateTransactionDTO) CheckCreateInventoryDTO() (bool, error) {
 		return false, errors.New("Quantity는 필수 입력 사항입니다")
 	}
 
+	if c.UnitCost < 0 {
+		return false, errors.New("단가는 0 이상이어야 합니다")
+	}
+
+	if c.ManufacturedAt != nil && c.ExpiresAt != nil && c.ExpiresAt.Before(*c.ManufacturedAt) {
+		return false, errors.New("유통기한은 제조일 이후여야 합니다")
+	}
+
+	if ok, err := checkSerials(c.Serials); !ok {
+		return false, err
+	}
+
 	return true, nil
 }
 
 func (c *CreateTransactionDTO) ToModel() *models.Transaction {
 	return &models.Transaction{
-		InventoryID: c.InventoryID,
-		Type:        c.Type,
-		Quantity:    c.Quantity,
-		Timestamp:   models.GetNowTime(),
+		InventoryID:    c.InventoryID,
+		Type:           c.Type,
+		Quantity:       c.Quantity,
+		Unit:           c.Unit,
+		Timestamp:      models.GetNowTime(),
+		BinLocationID:  c.BinLocationID,
+		UnitCost:       c.UnitCost,
+		LotNumber:      c.LotNumber,
+		ManufacturedAt: c.ManufacturedAt,
+		ExpiresAt:      c.ExpiresAt,
+		Serials:        c.Serials,
 	}
 }
+
+type TransferTransactionDTO struct {
+	SourceWarehouseID      uint `json:"source_warehouse_id"`
+	DestinationWarehouseID uint `json:"destination_warehouse_id"`
+	ProductID              uint `json:"product_id"`
+	Quantity               int  `json:"quantity"`
+
+	Serials []string `json:"serials"` // 일련번호 관리 제품 필수
+}
+
+func (t *TransferTransactionDTO) CheckTransferTransactionDTO() (bool, error) {
+	if t.SourceWarehouseID == 0 {
+		return false, errors.New("출발 창고 ID는 필수 입력 사항입니다")
+	}
+
+	if t.DestinationWarehouseID == 0 {
+		return false, errors.New("도착 창고 ID는 필수 입력 사항입니다")
+	}
+
+	if t.SourceWarehouseID == t.DestinationWarehouseID {
+		return false, errors.New("출발 창고와 도착 창고가 같을 수 없습니다")
+	}
+
+	if t.ProductID == 0 {
+		return false, errors.New("제품 ID는 필수 입력 사항입니다")
+	}
+
+	if t.Quantity <= 0 {
+		return false, errors.New("이동 수량은 1개 이상이어야 합니다")
+	}
+
+	if ok, err := checkSerials(t.Serials); !ok {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// 일련번호 목록의 빈 값/중복 확인
+func checkSerials(serials []string) (bool, error) {
+	seen := make(map[string]bool, len(serials))
+	for _, serial := range serials {
+		if serial == "" {
+			return false, errors.New("일련번호는 빈 값일 수 없습니다")
+		}
+		if seen[serial] {
+			return false, errors.New("중복된 일련번호가 있습니다 (" + serial + ")")
+		}
+		seen[serial] = true
+	}
+
+	return true, nil
+}
+
+// 한 번에 처리할 수 있는 최대 재고내역 수
+const maxBatchTransactions = 1000
+
+type CreateTransactionBatchDTO struct {
+	Mode         string                 `json:"mode"` // ATOMIC(기본), BEST_EFFORT
+	Transactions []CreateTransactionDTO `json:"transactions"`
+}
+
+func (c *CreateTransactionBatchDTO) CheckCreateTransactionBatchDTO() (bool, error) {
+	if c.Mode == "" {
+		c.Mode = models.BATCH_MODE_ATOMIC
+	}
+
+	if c.Mode != models.BATCH_MODE_ATOMIC && c.Mode != models.BATCH_MODE_BEST_EFFORT {
+		return false, errors.New("Mode는 ATOMIC 또는 BEST_EFFORT 이어야 합니다")
+	}
+
+	if len(c.Transactions) == 0 {
+		return false, errors.New("재고내역은 1개 이상이어야 합니다")
+	}
+
+	if len(c.Transactions) > maxBatchTransactions {
+		return false, fmt.Errorf("재고내역은 한 번에 %d개까지 처리할 수 있습니다", maxBatchTransactions)
+	}
+
+	// ATOMIC 모드는 하나라도 잘못된 항목이 있으면 처리하지 않음
+	if c.Mode == models.BATCH_MODE_ATOMIC {
+		for i := range c.Transactions {
+			if ok, err := c.Transactions[i].CheckCreateInventoryDTO(); !ok {
+				return false, fmt.Errorf("%d번째 항목: %v", i+1, err)
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// 항목별 입력값 오류 ( 요청 목록의 순서 → 오류 )
+func (c *CreateTransactionBatchDTO) LineErrors() map[int]error {
+	lineErrors := make(map[int]error)
+	for i := range c.Transactions {
+		if ok, err := c.Transactions[i].CheckCreateInventoryDTO(); !ok {
+			lineErrors[i] = err
+		}
+	}
+
+	return lineErrors
+}
+
+func (c *CreateTransactionBatchDTO) ToModels() []*models.Transaction {
+	transactions := make([]*models.Transaction, len(c.Transactions))
+	for i := range c.Transactions {
+		transactions[i] = c.Transactions[i].ToModel()
+	}
+
+	return transactions
+}
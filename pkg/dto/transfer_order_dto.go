@@ -0,0 +1,167 @@
+package dto
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"errors"
+)
+
+type CreateTransferOrderItemDTO struct {
+	ProductID uint `json:"product_id"`
+	Quantity  int  `json:"quantity"`
+}
+
+type CreateTransferOrderDTO struct {
+	SourceWarehouseID      uint                         `json:"source_warehouse_id"`
+	DestinationWarehouseID uint                         `json:"destination_warehouse_id"`
+	Note                   string                       `json:"note"`
+	Items                  []CreateTransferOrderItemDTO `json:"items"`
+}
+
+func (c *CreateTransferOrderDTO) CheckCreateTransferOrderDTO() (bool, error) {
+	if c.SourceWarehouseID == 0 {
+		return false, errors.New("출발 창고 ID는 필수 입력 사항입니다")
+	}
+
+	if c.DestinationWarehouseID == 0 {
+		return false, errors.New("도착 창고 ID는 필수 입력 사항입니다")
+	}
+
+	if c.SourceWarehouseID == c.DestinationWarehouseID {
+		return false, errors.New("출발 창고와 도착 창고가 같을 수 없습니다")
+	}
+
+	if len(c.Items) == 0 {
+		return false, errors.New("이동 항목은 최소 1개 이상이어야 합니다")
+	}
+
+	products := make(map[uint]bool)
+	for _, item := range c.Items {
+		if item.ProductID == 0 {
+			return false, errors.New("제품 ID는 필수 입력 사항입니다")
+		}
+
+		if item.Quantity <= 0 {
+			return false, errors.New("이동 수량은 1개 이상이어야 합니다")
+		}
+
+		if products[item.ProductID] {
+			return false, errors.New("같은 제품을 중복으로 등록할 수 없습니다")
+		}
+		products[item.ProductID] = true
+	}
+
+	return true, nil
+}
+
+func (c *CreateTransferOrderDTO) ToModel(userID uint) *models.TransferOrder {
+	items := make([]models.TransferOrderItem, 0, len(c.Items))
+	for _, item := range c.Items {
+		items = append(items, models.TransferOrderItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	return &models.TransferOrder{
+		UserID:                 userID,
+		SourceWarehouseID:      c.SourceWarehouseID,
+		DestinationWarehouseID: c.DestinationWarehouseID,
+		Note:                   c.Note,
+		Status:                 models.TRANSFER_STATUS_DRAFT,
+		Items:                  items,
+	}
+}
+
+type DispatchTransferOrderItemDTO struct {
+	ItemID  uint     `json:"item_id"`
+	Serials []string `json:"serials"` // 일련번호 관리 제품 필수 ( 수량만큼 )
+}
+
+type DispatchTransferOrderDTO struct {
+	Items []DispatchTransferOrderItemDTO `json:"items"` // 일련번호 관리 제품의 항목별 출고 일련번호 ( 선택 )
+}
+
+func (d *DispatchTransferOrderDTO) CheckDispatchTransferOrderDTO() (bool, error) {
+	items := make(map[uint]bool)
+	for _, item := range d.Items {
+		if item.ItemID == 0 {
+			return false, errors.New("항목 ID는 필수 입력 사항입니다")
+		}
+
+		if items[item.ItemID] {
+			return false, errors.New("같은 항목을 중복으로 입력할 수 없습니다")
+		}
+		items[item.ItemID] = true
+
+		if ok, err := checkSerials(item.Serials); !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// 항목 ID -> 출고 일련번호
+func (d *DispatchTransferOrderDTO) ToSerials() map[uint][]string {
+	serials := make(map[uint][]string, len(d.Items))
+	for _, item := range d.Items {
+		serials[item.ItemID] = item.Serials
+	}
+
+	return serials
+}
+
+type ReceiveTransferOrderItemDTO struct {
+	ItemID    uint     `json:"item_id"`
+	Quantity  int      `json:"quantity"`
+	LotNumber string   `json:"lot_number"` // 로트 관리 제품 선택 ( 출고된 로트 중 지정, 미지정 시 출고 순서대로 )
+	Serials   []string `json:"serials"`    // 일련번호 관리 제품 필수 ( 출고된 일련번호 중 수량만큼 )
+}
+
+type ReceiveTransferOrderDTO struct {
+	Items []ReceiveTransferOrderItemDTO `json:"items"`
+}
+
+func (r *ReceiveTransferOrderDTO) CheckReceiveTransferOrderDTO() (bool, error) {
+	if len(r.Items) == 0 {
+		return false, errors.New("입고 항목은 최소 1개 이상이어야 합니다")
+	}
+
+	items := make(map[uint]bool)
+	for _, item := range r.Items {
+		if item.ItemID == 0 {
+			return false, errors.New("항목 ID는 필수 입력 사항입니다")
+		}
+
+		if item.Quantity <= 0 {
+			return false, errors.New("입고 수량은 1개 이상이어야 합니다")
+		}
+
+		if items[item.ItemID] {
+			return false, errors.New("같은 항목을 중복으로 입고할 수 없습니다")
+		}
+		items[item.ItemID] = true
+
+		if ok, err := checkSerials(item.Serials); !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// 항목 ID -> 입고 요청
+func (r *ReceiveTransferOrderDTO) ToReceipts() map[uint]models.TransferOrderReceipt {
+	receipts := make(map[uint]models.TransferOrderReceipt, len(r.Items))
+	for _, item := range r.Items {
+		receipts[item.ItemID] = models.TransferOrderReceipt{
+			ItemID:    item.ItemID,
+			Quantity:  item.Quantity,
+			LotNumber: item.LotNumber,
+			Serials:   item.Serials,
+		}
+	}
+
+	return receipts
+}
@@ -7,8 +7,9 @@ import (
 )
 
 type CreateWarehouseDTO struct {
-	Name     string `json:"name" binding:"required"`
-	Location string `json:"location" binding:"required"`
+	Name                string `json:"name" binding:"required"`
+	Location            string `json:"location" binding:"required"`
+	NegativeStockPolicy string `json:"negative_stock_policy"` // 미입력 시 FORBID
 }
 
 func (c *CreateWarehouseDTO) CheckCreateWarehouseDTO() (bool, error) {
@@ -20,12 +21,73 @@ func (c *CreateWarehouseDTO) CheckCreateWarehouseDTO() (bool, error) {
 		return false, errors.New("창고 위치는 필수 입력 사항입니다")
 	}
 
+	switch c.NegativeStockPolicy {
+	case "", models.NEGATIVE_STOCK_FORBID, models.NEGATIVE_STOCK_WARN, models.NEGATIVE_STOCK_ALLOW:
+	default:
+		return false, errors.New("음수 재고 정책은 FORBID, WARN, ALLOW 중 하나여야 합니다")
+	}
+
 	return true, nil
 }
 
 func (c *CreateWarehouseDTO) ToModel() *models.Warehouse {
+	policy := c.NegativeStockPolicy
+	if policy == "" {
+		policy = models.NEGATIVE_STOCK_FORBID
+	}
+
 	return &models.Warehouse{
-		Name:     c.Name,
-		Location: c.Location,
+		Name:                c.Name,
+		Location:            c.Location,
+		NegativeStockPolicy: policy,
+	}
+}
+
+// 창고 수정 ( PATCH: 입력한 항목만 변경, PUT: 필수 항목을 모두 입력하고 나머지는 기본값으로 변경 )
+type UpdateWarehouseDTO struct {
+	Name                *string `json:"name"`
+	Location            *string `json:"location"`
+	NegativeStockPolicy *string `json:"negative_stock_policy"`
+}
+
+func (u *UpdateWarehouseDTO) CheckUpdateWarehouseDTO(replace bool) (bool, error) {
+	if replace && (u.Name == nil || u.Location == nil) {
+		return false, errors.New("창고 이름과 위치는 필수 입력 사항입니다")
 	}
+
+	if u.Name != nil && *u.Name == "" {
+		return false, errors.New("창고 이름은 빈 값일 수 없습니다")
+	}
+
+	if u.Location != nil && *u.Location == "" {
+		return false, errors.New("창고 위치는 빈 값일 수 없습니다")
+	}
+
+	if u.NegativeStockPolicy != nil {
+		switch *u.NegativeStockPolicy {
+		case models.NEGATIVE_STOCK_FORBID, models.NEGATIVE_STOCK_WARN, models.NEGATIVE_STOCK_ALLOW:
+		default:
+			return false, errors.New("음수 재고 정책은 FORBID, WARN, ALLOW 중 하나여야 합니다")
+		}
+	}
+
+	return true, nil
+}
+
+// 변경할 컬럼과 값
+func (u *UpdateWarehouseDTO) ToUpdates(replace bool) map[string]interface{} {
+	updates := make(map[string]interface{})
+	if u.Name != nil {
+		updates["name"] = *u.Name
+	}
+	if u.Location != nil {
+		updates["location"] = *u.Location
+	}
+	if u.NegativeStockPolicy != nil {
+		updates["negative_stock_policy"] = *u.NegativeStockPolicy
+	} else if replace {
+		updates["negative_stock_policy"] = models.NEGATIVE_STOCK_FORBID
+	}
+
+	return updates
 }
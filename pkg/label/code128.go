@@ -0,0 +1,58 @@
+package label
+
+import (
+	"errors"
+)
+
+// Code128 심볼별 막대/공백 너비 ( 0 ~ 102: 데이터, 103 ~ 105: 시작 A/B/C, 106: 정지 )
+var code128Patterns = [...]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+const (
+	code128StartB    = 104
+	code128Stop      = 106
+	code128QuietZone = 10 // 좌우 여백 ( 모듈 수 )
+)
+
+// Code128 ( 코드셋 B ) 로 인코딩한 모듈 배열 반환 ( true: 막대, 좌우 여백 포함 )
+func EncodeCode128(value string) ([]bool, error) {
+	if len(value) == 0 || len(value) > 80 {
+		return nil, errors.New("Code128 값은 1 ~ 80자여야 합니다")
+	}
+
+	symbols := []int{code128StartB}
+	checksum := code128StartB
+	for i := 0; i < len(value); i++ {
+		if value[i] < 32 || value[i] > 126 {
+			return nil, errors.New("Code128 로 표현할 수 없는 문자가 포함되어 있습니다")
+		}
+
+		symbol := int(value[i] - 32)
+		symbols = append(symbols, symbol)
+		checksum += symbol * (i + 1)
+	}
+	symbols = append(symbols, checksum%103, code128Stop)
+
+	modules := make([]bool, code128QuietZone)
+	for _, symbol := range symbols {
+		for i, width := range code128Patterns[symbol] {
+			for w := 0; w < int(width-'0'); w++ {
+				modules = append(modules, i%2 == 0)
+			}
+		}
+	}
+	modules = append(modules, make([]bool, code128QuietZone)...)
+
+	return modules, nil
+}
@@ -0,0 +1,140 @@
+package label
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+const (
+	TYPE_CODE128 = "CODE128" // 1차원 바코드
+	TYPE_QR      = "QR"      // 2차원 QR 코드
+)
+
+const (
+	FORMAT_PNG = "png"
+	FORMAT_SVG = "svg"
+	FORMAT_PDF = "pdf"
+)
+
+const (
+	code128ModulePixels = 2  // PNG/SVG 막대 1모듈 너비 ( px )
+	code128BarPixels    = 80 // PNG/SVG 막대 높이 ( px )
+	qrModulePixels      = 8  // PNG/SVG QR 1모듈 크기 ( px )
+	svgLinePixels       = 16 // SVG 설명 문구 줄 높이 ( px )
+)
+
+/* 출력할 라벨 ( 심볼에 담을 값과 사람이 읽는 설명 문구 ) */
+type Label struct {
+	Value string   `json:"value"`
+	Lines []string `json:"lines"`
+}
+
+// 라벨 유형별 모듈 행렬 ( Code128 은 1행 )
+func Encode(labelType, value string) ([][]bool, error) {
+	switch labelType {
+	case "", TYPE_CODE128:
+		modules, err := EncodeCode128(value)
+		if err != nil {
+			return nil, err
+		}
+		return [][]bool{modules}, nil
+	case TYPE_QR:
+		return EncodeQR(value)
+	}
+
+	return nil, fmt.Errorf("지원하지 않는 라벨 유형입니다 (%s)", labelType)
+}
+
+// 라벨 유형별 모듈 1개의 가로/세로 픽셀 크기
+func modulePixels(labelType string) (int, int) {
+	if labelType == TYPE_QR {
+		return qrModulePixels, qrModulePixels
+	}
+	return code128ModulePixels, code128BarPixels
+}
+
+// 단일 라벨을 PNG 또는 SVG 로 출력 ( PNG 는 심볼만, SVG 는 설명 문구 포함 )
+func Render(l Label, labelType, format string) ([]byte, string, error) {
+	matrix, err := Encode(labelType, l.Value)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch format {
+	case "", FORMAT_PNG:
+		data, err := renderPNG(matrix, labelType)
+		return data, "image/png", err
+	case FORMAT_SVG:
+		return renderSVG(l, matrix, labelType), "image/svg+xml", nil
+	}
+
+	return nil, "", errors.New("지원하지 않는 출력 형식입니다")
+}
+
+func renderPNG(matrix [][]bool, labelType string) ([]byte, error) {
+	moduleWidth, moduleHeight := modulePixels(labelType)
+	img := image.NewGray(image.Rect(0, 0, len(matrix[0])*moduleWidth, len(matrix)*moduleHeight))
+	for i := range img.Pix {
+		img.Pix[i] = 0xFF
+	}
+
+	for y, row := range matrix {
+		for x, dark := range row {
+			if !dark {
+				continue
+			}
+			for py := y * moduleHeight; py < (y+1)*moduleHeight; py++ {
+				for px := x * moduleWidth; px < (x+1)*moduleWidth; px++ {
+					img.SetGray(px, py, color.Gray{Y: 0})
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func renderSVG(l Label, matrix [][]bool, labelType string) []byte {
+	moduleWidth, moduleHeight := modulePixels(labelType)
+	width := len(matrix[0]) * moduleWidth
+	symbolHeight := len(matrix) * moduleHeight
+	height := symbolHeight + len(l.Lines)*svgLinePixels
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, height, width, height)
+	fmt.Fprintf(&sb, `<rect width="%d" height="%d" fill="#fff"/>`, width, height)
+
+	// 같은 행의 연속된 어두운 모듈은 사각형 하나로 출력
+	for y, row := range matrix {
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+			start := x
+			for x+1 < len(row) && row[x+1] {
+				x++
+			}
+			fmt.Fprintf(&sb, `<rect x="%d" y="%d" width="%d" height="%d" fill="#000"/>`,
+				start*moduleWidth, y*moduleHeight, (x-start+1)*moduleWidth, moduleHeight)
+		}
+	}
+
+	for i, line := range l.Lines {
+		fmt.Fprintf(&sb, `<text x="%d" y="%d" font-family="sans-serif" font-size="12" text-anchor="middle">%s</text>`,
+			width/2, symbolHeight+(i+1)*svgLinePixels-4, html.EscapeString(line))
+	}
+	sb.WriteString("</svg>")
+
+	return []byte(sb.String())
+}
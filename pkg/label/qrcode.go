@@ -0,0 +1,451 @@
+package label
+
+import (
+	"errors"
+)
+
+// QR 코드 버전별 블록 구성 ( 오류 정정 수준 M, 버전 1 ~ 10 )
+type qrVersion struct {
+	ecPerBlock int      // 블록당 오류 정정 코드워드 수
+	blocks     [][2]int // { 블록 수, 블록당 데이터 코드워드 수 }
+	alignments []int    // 정렬 패턴 중심 좌표
+}
+
+var qrVersions = [...]qrVersion{
+	{10, [][2]int{{1, 16}}, nil},
+	{16, [][2]int{{1, 28}}, []int{6, 18}},
+	{26, [][2]int{{1, 44}}, []int{6, 22}},
+	{18, [][2]int{{2, 32}}, []int{6, 26}},
+	{24, [][2]int{{2, 43}}, []int{6, 30}},
+	{16, [][2]int{{4, 27}}, []int{6, 34}},
+	{18, [][2]int{{4, 31}}, []int{6, 22, 38}},
+	{22, [][2]int{{2, 38}, {2, 39}}, []int{6, 24, 42}},
+	{22, [][2]int{{3, 36}, {2, 37}}, []int{6, 26, 46}},
+	{26, [][2]int{{4, 43}, {1, 44}}, []int{6, 28, 50}},
+}
+
+const qrQuietZone = 4 // 사방 여백 ( 모듈 수 )
+
+func (v qrVersion) dataCodewords() int {
+	total := 0
+	for _, block := range v.blocks {
+		total += block[0] * block[1]
+	}
+	return total
+}
+
+type qrCode struct {
+	size     int
+	modules  [][]bool // [y][x], true: 어두운 모듈
+	function [][]bool // 기능 패턴 여부 ( 데이터/마스크 적용 제외 )
+}
+
+// QR 코드 ( 바이트 모드, 오류 정정 수준 M ) 로 인코딩한 모듈 행렬 반환 ( 사방 여백 포함 )
+func EncodeQR(value string) ([][]bool, error) {
+	data := []byte(value)
+	if len(data) == 0 {
+		return nil, errors.New("QR 코드 값은 비어 있을 수 없습니다")
+	}
+
+	for number := 1; number <= len(qrVersions); number++ {
+		version := qrVersions[number-1]
+		countBits := 8
+		if number >= 10 {
+			countBits = 16
+		}
+		if 4+countBits+len(data)*8 > version.dataCodewords()*8 {
+			continue
+		}
+
+		codewords := qrInterleave(version, qrDataCodewords(data, countBits, version.dataCodewords()))
+		return newQRCode(number, version, codewords).withQuietZone(), nil
+	}
+
+	return nil, errors.New("QR 코드로 표현하기에 값이 너무 깁니다")
+}
+
+// 모드 지시자 + 길이 + 데이터 + 종료/패딩 코드워드
+func qrDataCodewords(data []byte, countBits, capacity int) []byte {
+	var bits []bool
+	appendBits := func(value, length int) {
+		for i := length - 1; i >= 0; i-- {
+			bits = append(bits, (value>>i)&1 == 1)
+		}
+	}
+
+	appendBits(0x4, 4) // 바이트 모드
+	appendBits(len(data), countBits)
+	for _, b := range data {
+		appendBits(int(b), 8)
+	}
+
+	terminator := capacity*8 - len(bits)
+	if terminator > 4 {
+		terminator = 4
+	}
+	appendBits(0, terminator)
+	if len(bits)%8 != 0 {
+		appendBits(0, 8-len(bits)%8)
+	}
+
+	codewords := make([]byte, 0, capacity)
+	for i := 0; i < len(bits); i += 8 {
+		var b byte
+		for j := 0; j < 8; j++ {
+			if bits[i+j] {
+				b |= 1 << (7 - j)
+			}
+		}
+		codewords = append(codewords, b)
+	}
+	for pad := byte(0xEC); len(codewords) < capacity; pad ^= 0xEC ^ 0x11 {
+		codewords = append(codewords, pad)
+	}
+
+	return codewords
+}
+
+// 블록별 오류 정정 코드워드를 붙이고 블록 간 교차 배치
+func qrInterleave(version qrVersion, data []byte) []byte {
+	var dataBlocks, ecBlocks [][]byte
+	generator := rsGenerator(version.ecPerBlock)
+
+	offset := 0
+	for _, group := range version.blocks {
+		for i := 0; i < group[0]; i++ {
+			block := data[offset : offset+group[1]]
+			offset += group[1]
+			dataBlocks = append(dataBlocks, block)
+			ecBlocks = append(ecBlocks, rsRemainder(block, generator))
+		}
+	}
+
+	var result []byte
+	maxLength := len(dataBlocks[len(dataBlocks)-1])
+	for i := 0; i < maxLength; i++ {
+		for _, block := range dataBlocks {
+			if i < len(block) {
+				result = append(result, block[i])
+			}
+		}
+	}
+	for i := 0; i < version.ecPerBlock; i++ {
+		for _, block := range ecBlocks {
+			result = append(result, block[i])
+		}
+	}
+
+	return result
+}
+
+// GF(256) 곱셈 ( 원시 다항식 0x11D )
+func gfMultiply(x, y byte) byte {
+	var z int
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+// 리드-솔로몬 생성 다항식 계수 ( 최고차항 제외 )
+func rsGenerator(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+
+	return result
+}
+
+func rsRemainder(data, generator []byte) []byte {
+	result := make([]byte, len(generator))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i := range result {
+			result[i] ^= gfMultiply(generator[i], factor)
+		}
+	}
+
+	return result
+}
+
+func newQRCode(number int, version qrVersion, codewords []byte) *qrCode {
+	size := number*4 + 17
+	q := &qrCode{
+		size:     size,
+		modules:  make([][]bool, size),
+		function: make([][]bool, size),
+	}
+	for i := 0; i < size; i++ {
+		q.modules[i] = make([]bool, size)
+		q.function[i] = make([]bool, size)
+	}
+
+	q.drawFunctionPatterns(number, version)
+	q.drawCodewords(codewords)
+
+	// 벌점이 가장 낮은 마스크 선택
+	bestMask, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		q.applyMask(mask)
+		q.drawFormatBits(mask)
+		if penalty := q.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			bestMask, bestPenalty = mask, penalty
+		}
+		q.applyMask(mask)
+	}
+	q.applyMask(bestMask)
+	q.drawFormatBits(bestMask)
+
+	return q
+}
+
+func (q *qrCode) set(x, y int, dark bool) {
+	q.modules[y][x] = dark
+	q.function[y][x] = true
+}
+
+func (q *qrCode) drawFunctionPatterns(number int, version qrVersion) {
+	// 타이밍 패턴
+	for i := 0; i < q.size; i++ {
+		q.set(6, i, i%2 == 0)
+		q.set(i, 6, i%2 == 0)
+	}
+
+	// 위치 찾기 패턴 ( 분리 영역 포함 )
+	for _, center := range [][2]int{{3, 3}, {q.size - 4, 3}, {3, q.size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := center[0]+dx, center[1]+dy
+				if x < 0 || x >= q.size || y < 0 || y >= q.size {
+					continue
+				}
+				distance := max(abs(dx), abs(dy))
+				q.set(x, y, distance != 2 && distance != 4)
+			}
+		}
+	}
+
+	// 정렬 패턴 ( 위치 찾기 패턴과 겹치는 위치 제외 )
+	last := len(version.alignments) - 1
+	for i, cy := range version.alignments {
+		for j, cx := range version.alignments {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					q.set(cx+dx, cy+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	// 포맷 정보 영역 예약
+	q.drawFormatBits(0)
+
+	// 버전 정보 ( 버전 7 이상 )
+	if number >= 7 {
+		remainder := number
+		for i := 0; i < 12; i++ {
+			remainder = (remainder << 1) ^ ((remainder >> 11) * 0x1F25)
+		}
+		bits := number<<12 | remainder
+		for i := 0; i < 18; i++ {
+			dark := (bits>>i)&1 == 1
+			a, b := q.size-11+i%3, i/3
+			q.set(a, b, dark)
+			q.set(b, a, dark)
+		}
+	}
+}
+
+// 포맷 정보 ( 오류 정정 수준 M + 마스크 번호 ) 두 벌 기록
+func (q *qrCode) drawFormatBits(mask int) {
+	data := 0<<3 | mask // 오류 정정 수준 M = 00
+	remainder := data
+	for i := 0; i < 10; i++ {
+		remainder = (remainder << 1) ^ ((remainder >> 9) * 0x537)
+	}
+	bits := (data<<10 | remainder) ^ 0x5412
+	bit := func(i int) bool { return (bits>>i)&1 == 1 }
+
+	for i := 0; i <= 5; i++ {
+		q.set(8, i, bit(i))
+	}
+	q.set(8, 7, bit(6))
+	q.set(8, 8, bit(7))
+	q.set(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		q.set(14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		q.set(q.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		q.set(8, q.size-15+i, bit(i))
+	}
+	q.set(8, q.size-8, true) // 항상 어두운 모듈
+}
+
+// 오른쪽 아래부터 두 열씩 지그재그로 데이터 배치
+func (q *qrCode) drawCodewords(codewords []byte) {
+	i := 0
+	for right := q.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < q.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = q.size - 1 - vert
+				}
+				if !q.function[y][x] && i < len(codewords)*8 {
+					q.modules[y][x] = (codewords[i>>3]>>(7-i&7))&1 == 1
+					i++
+				}
+			}
+		}
+	}
+}
+
+func (q *qrCode) applyMask(mask int) {
+	for y := 0; y < q.size; y++ {
+		for x := 0; x < q.size; x++ {
+			if q.function[y][x] {
+				continue
+			}
+
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if invert {
+				q.modules[y][x] = !q.modules[y][x]
+			}
+		}
+	}
+}
+
+// 마스크 선택용 벌점 ( 연속 모듈, 2x2 블록, 위치 찾기 패턴 유사 배열, 명암 비율 )
+func (q *qrCode) penalty() int {
+	penalty := 0
+	finderLike := []bool{true, false, true, true, true, false, true}
+
+	line := func(get func(i int) bool) {
+		run := 1
+		for i := 1; i < q.size; i++ {
+			if get(i) == get(i-1) {
+				run++
+				continue
+			}
+			if run >= 5 {
+				penalty += run - 2
+			}
+			run = 1
+		}
+		if run >= 5 {
+			penalty += run - 2
+		}
+
+		for i := 0; i+7 <= q.size; i++ {
+			match := true
+			for j, dark := range finderLike {
+				if get(i+j) != dark {
+					match = false
+					break
+				}
+			}
+			if !match {
+				continue
+			}
+
+			lightBefore, lightAfter := true, true
+			for j := 1; j <= 4; j++ {
+				if i-j >= 0 && get(i-j) {
+					lightBefore = false
+				}
+				if i+6+j < q.size && get(i+6+j) {
+					lightAfter = false
+				}
+			}
+			if lightBefore || lightAfter {
+				penalty += 40
+			}
+		}
+	}
+
+	dark := 0
+	for y := 0; y < q.size; y++ {
+		line(func(i int) bool { return q.modules[y][i] })
+		line(func(i int) bool { return q.modules[i][y] })
+
+		for x := 0; x < q.size; x++ {
+			if q.modules[y][x] {
+				dark++
+			}
+			if x+1 < q.size && y+1 < q.size {
+				color := q.modules[y][x]
+				if q.modules[y][x+1] == color && q.modules[y+1][x] == color && q.modules[y+1][x+1] == color {
+					penalty += 3
+				}
+			}
+		}
+	}
+
+	total := q.size * q.size
+	deviation := abs(dark*20-total*10) / total
+	penalty += deviation * 10
+
+	return penalty
+}
+
+func (q *qrCode) withQuietZone() [][]bool {
+	size := q.size + qrQuietZone*2
+	result := make([][]bool, size)
+	for y := range result {
+		result[y] = make([]bool, size)
+	}
+	for y := 0; y < q.size; y++ {
+		copy(result[y+qrQuietZone][qrQuietZone:], q.modules[y])
+	}
+
+	return result
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
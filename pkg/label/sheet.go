@@ -0,0 +1,154 @@
+package label
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// A4 라벨 용지 배치 ( 단위: pt, 3열 x 8행 )
+const (
+	sheetWidth    = 595.28
+	sheetHeight   = 841.89
+	sheetColumns  = 3
+	sheetRows     = 8
+	labelPadding  = 8.0
+	labelFontSize = 8.0
+	labelLeading  = 10.0
+	labelMaxChars = 40
+)
+
+// 여러 라벨을 A4 용지에 배치한 PDF 출력 ( 용지당 24개 )
+func RenderSheet(labels []Label, labelType string) ([]byte, error) {
+	if len(labels) == 0 {
+		return nil, errors.New("출력할 라벨이 없습니다")
+	}
+
+	perPage := sheetColumns * sheetRows
+	var pages []string
+	for start := 0; start < len(labels); start += perPage {
+		end := min(start+perPage, len(labels))
+
+		var content strings.Builder
+		for i, l := range labels[start:end] {
+			x := float64(i%sheetColumns) * sheetWidth / sheetColumns
+			y := sheetHeight - float64(i/sheetColumns+1)*sheetHeight/sheetRows
+			if err := drawLabel(&content, l, labelType, x, y); err != nil {
+				return nil, err
+			}
+		}
+		pages = append(pages, content.String())
+	}
+
+	return writePDF(pages), nil
+}
+
+// 라벨 한 칸 그리기 ( x, y: 칸의 왼쪽 아래 )
+func drawLabel(content *strings.Builder, l Label, labelType string, x, y float64) error {
+	matrix, err := Encode(labelType, l.Value)
+	if err != nil {
+		return err
+	}
+
+	width := sheetWidth/sheetColumns - labelPadding*2
+	height := sheetHeight/sheetRows - labelPadding*2
+	left, bottom := x+labelPadding, y+labelPadding
+
+	var symbolX, symbolY, moduleWidth, moduleHeight, textX float64
+	textTop := bottom + height
+	if labelType == TYPE_QR {
+		// QR 코드는 왼쪽 정사각형, 설명 문구는 오른쪽
+		moduleWidth = height / float64(len(matrix))
+		moduleHeight = moduleWidth
+		symbolX, symbolY = left, bottom
+		textX = left + height + labelPadding
+	} else {
+		// Code128 은 설명 문구 아래에 칸 너비만큼
+		moduleWidth = width / float64(len(matrix[0]))
+		moduleHeight = height - float64(len(l.Lines))*labelLeading - labelPadding
+		symbolX, symbolY = left, bottom
+		textX = left
+	}
+
+	content.WriteString("0 g\n")
+	for row, modules := range matrix {
+		top := symbolY + float64(len(matrix)-row)*moduleHeight
+		for col := 0; col < len(modules); col++ {
+			if !modules[col] {
+				continue
+			}
+			start := col
+			for col+1 < len(modules) && modules[col+1] {
+				col++
+			}
+			fmt.Fprintf(content, "%.3f %.3f %.3f %.3f re f\n",
+				symbolX+float64(start)*moduleWidth, top-moduleHeight, float64(col-start+1)*moduleWidth, moduleHeight)
+		}
+	}
+
+	for i, line := range l.Lines {
+		fmt.Fprintf(content, "BT /F1 %.0f Tf %.2f %.2f Td (%s) Tj ET\n",
+			labelFontSize, textX, textTop-float64(i+1)*labelLeading+2, pdfText(line))
+	}
+
+	return nil
+}
+
+// PDF 기본 글꼴(Helvetica)로 출력 가능한 문자만 남기고 특수 문자 이스케이프
+func pdfText(text string) string {
+	var sb strings.Builder
+	count := 0
+	for _, r := range text {
+		if count == labelMaxChars {
+			break
+		}
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r >= 32 && r <= 126:
+			sb.WriteRune(r)
+		default:
+			sb.WriteByte('?')
+		}
+		count++
+	}
+
+	return sb.String()
+}
+
+// 페이지별 내용 스트림으로 PDF 문서 작성
+func writePDF(pages []string) []byte {
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	// 1: 카탈로그, 2: 페이지 목록, 3: 글꼴, 4 이후: 페이지 + 내용 스트림
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+i*2)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>")
+	for i, content := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			sheetWidth, sheetHeight, 5+i*2))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.Bytes()
+}
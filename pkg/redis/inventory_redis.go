@@ -10,13 +10,12 @@ import (
 	"errors"
 	"context"
 	"strconv"
-	"encoding/json"
 )
 
 type InventoryRedis interface {
 	DeleteInventoryCache(ctx context.Context) error
-	SetInventoryCache(ctx context.Context, inventorys []models.Inventory) error
-	GetInventoryCache(ctx context.Context) ([]models.Inventory, error)
+	SetInventoryCache(ctx context.Context, page models.PageQuery, inventorys []models.Inventory, pagination *models.Pagination) error
+	GetInventoryCache(ctx context.Context, page models.PageQuery) ([]models.Inventory, *models.Pagination, error)
 	Close() error
 }
 
@@ -74,31 +73,15 @@ func GetInventoryRedis(ctx context.Context) (InventoryRedis, error) {
 
 
 func (r *inventoryRedis) DeleteInventoryCache(ctx context.Context) error {
-	return r.client.Del(ctx, REDIS_INVENTORY_CACHE_KEY).Err()
+	return deletePageCache(ctx, r.client, REDIS_INVENTORY_CACHE_KEY)
 }
 
-func (r *inventoryRedis) SetInventoryCache(ctx context.Context, inventorys []models.Inventory) error {
-	inventoryBytes, err := json.Marshal(inventorys)
-	if err != nil {
-		return err
-	}
-
-	return r.client.Set(ctx, REDIS_INVENTORY_CACHE_KEY, inventoryBytes, 0).Err()
+func (r *inventoryRedis) SetInventoryCache(ctx context.Context, page models.PageQuery, inventorys []models.Inventory, pagination *models.Pagination) error {
+	return setPageCache(ctx, r.client, REDIS_INVENTORY_CACHE_KEY, page, inventorys, pagination)
 }
 
-func (r *inventoryRedis) GetInventoryCache(ctx context.Context) ([]models.Inventory, error) {
-	inventoryBytes, err := r.client.Get(ctx, REDIS_INVENTORY_CACHE_KEY).Bytes()
-	if err != nil {
-		return nil, err
-	}
-
-	var inventorys []models.Inventory
-	err = json.Unmarshal(inventoryBytes, &inventorys)
-	if err != nil {
-		return nil, err
-	}
-
-	return inventorys, nil
+func (r *inventoryRedis) GetInventoryCache(ctx context.Context, page models.PageQuery) ([]models.Inventory, *models.Pagination, error) {
+	return getPageCache[models.Inventory](ctx, r.client, REDIS_INVENTORY_CACHE_KEY, page)
 }
 
 func (r *inventoryRedis) Close() error {
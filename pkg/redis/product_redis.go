@@ -10,13 +10,12 @@ import (
 	"errors"
 	"context"
 	"strconv"
-	"encoding/json"
 )
 
 type ProductRedis interface {
 	DeleteProductCache(ctx context.Context) error
-	SetProductCache(ctx context.Context, products []models.Product) error
-	GetProductCache(ctx context.Context) ([]models.Product, error)
+	SetProductCache(ctx context.Context, page models.PageQuery, products []models.Product, pagination *models.Pagination) error
+	GetProductCache(ctx context.Context, page models.PageQuery) ([]models.Product, *models.Pagination, error)
 	Close() error
 }
 
@@ -74,31 +73,15 @@ func GetProductRedis(ctx context.Context) (ProductRedis, error) {
 
 
 func (r *productRedis) DeleteProductCache(ctx context.Context) error {
-	return r.client.Del(ctx, REDIS_PRODUCT_CACHE_KEY).Err()
+	return deletePageCache(ctx, r.client, REDIS_PRODUCT_CACHE_KEY)
 }
 
-func (r *productRedis) SetProductCache(ctx context.Context, products []models.Product) error {
-	productBytes, err := json.Marshal(products)
-	if err != nil {
-		return err
-	}
-
-	return r.client.Set(ctx, REDIS_PRODUCT_CACHE_KEY, productBytes, 0).Err()
+func (r *productRedis) SetProductCache(ctx context.Context, page models.PageQuery, products []models.Product, pagination *models.Pagination) error {
+	return setPageCache(ctx, r.client, REDIS_PRODUCT_CACHE_KEY, page, products, pagination)
 }
 
-func (r *productRedis) GetProductCache(ctx context.Context) ([]models.Product, error) {
-	productBytes, err := r.client.Get(ctx, REDIS_PRODUCT_CACHE_KEY).Bytes()
-	if err != nil {
-		return nil, err
-	}
-
-	var products []models.Product
-	err = json.Unmarshal(productBytes, &products)
-	if err != nil {
-		return nil, err
-	}
-
-	return products, nil
+func (r *productRedis) GetProductCache(ctx context.Context, page models.PageQuery) ([]models.Product, *models.Pagination, error) {
+	return getPageCache[models.Product](ctx, r.client, REDIS_PRODUCT_CACHE_KEY, page)
 }
 
 func (r *productRedis) Close() error {
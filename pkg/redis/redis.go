@@ -1,6 +1,13 @@
 package redis
 
-import "context"
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"github.com/go-redis/redis/v8"
+
+	"context"
+	"encoding/json"
+)
 
 const (
 	REDIS_WAREHOUSE_CACHE_KEY = "warehouse_cache"
@@ -9,6 +16,59 @@ const (
 	REDIS_TRANSACTION_CACHE_KEY = "transaction_cache"
 )
 
+// 목록 캐시 값 ( 페이지 조건별로 목록과 페이지 정보를 함께 저장 )
+type pageCache[T any] struct {
+	Items      []T                `json:"items"`
+	Pagination *models.Pagination `json:"pagination"`
+}
+
+// 목록 캐시 키 ( 캐시 키 + 페이지 조건 )
+func pageCacheKey(cacheKey string, page models.PageQuery) string {
+	return cacheKey + ":" + page.CacheKey()
+}
+
+func setPageCache[T any](ctx context.Context, client *redis.Client, cacheKey string, page models.PageQuery, items []T, pagination *models.Pagination) error {
+	cacheBytes, err := json.Marshal(pageCache[T]{Items: items, Pagination: pagination})
+	if err != nil {
+		return err
+	}
+
+	return client.Set(ctx, pageCacheKey(cacheKey, page), cacheBytes, 0).Err()
+}
+
+func getPageCache[T any](ctx context.Context, client *redis.Client, cacheKey string, page models.PageQuery) ([]T, *models.Pagination, error) {
+	cacheBytes, err := client.Get(ctx, pageCacheKey(cacheKey, page)).Bytes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cache pageCache[T]
+	if err := json.Unmarshal(cacheBytes, &cache); err != nil {
+		return nil, nil, err
+	}
+
+	return cache.Items, cache.Pagination, nil
+}
+
+// 페이지 조건별로 저장된 목록 캐시 모두 삭제
+func deletePageCache(ctx context.Context, client *redis.Client, cacheKey string) error {
+	var keys []string
+
+	iter := client.Scan(ctx, 0, cacheKey+":*", 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	return client.Del(ctx, keys...).Err()
+}
+
 func RestoreRedisData(ctx context.Context) {
 	if warehouse_instance, err := GetWarehouseRedis(ctx); err == nil {
 		warehouse_instance.DeleteWarehouseCache(ctx)
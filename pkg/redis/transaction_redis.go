@@ -10,13 +10,12 @@ import (
 	"errors"
 	"context"
 	"strconv"
-	"encoding/json"
 )
 
 type TransactionRedis interface {
 	DeleteTransactionCache(ctx context.Context) error
-	SetTransactionCache(ctx context.Context, transactions []models.Transaction) error
-	GetTransactionCache(ctx context.Context) ([]models.Transaction, error)
+	SetTransactionCache(ctx context.Context, page models.PageQuery, transactions []models.Transaction, pagination *models.Pagination) error
+	GetTransactionCache(ctx context.Context, page models.PageQuery) ([]models.Transaction, *models.Pagination, error)
 	Close() error
 }
 
@@ -74,31 +73,15 @@ func GetTransactionRedis(ctx context.Context) (TransactionRedis, error) {
 
 
 func (r *transactionRedis) DeleteTransactionCache(ctx context.Context) error {
-	return r.client.Del(ctx, REDIS_TRANSACTION_CACHE_KEY).Err()
+	return deletePageCache(ctx, r.client, REDIS_TRANSACTION_CACHE_KEY)
 }
 
-func (r *transactionRedis) SetTransactionCache(ctx context.Context, transactions []models.Transaction) error {
-	transactionBytes, err := json.Marshal(transactions)
-	if err != nil {
-		return err
-	}
-
-	return r.client.Set(ctx, REDIS_TRANSACTION_CACHE_KEY, transactionBytes, 0).Err()
+func (r *transactionRedis) SetTransactionCache(ctx context.Context, page models.PageQuery, transactions []models.Transaction, pagination *models.Pagination) error {
+	return setPageCache(ctx, r.client, REDIS_TRANSACTION_CACHE_KEY, page, transactions, pagination)
 }
 
-func (r *transactionRedis) GetTransactionCache(ctx context.Context) ([]models.Transaction, error) {
-	transactionBytes, err := r.client.Get(ctx, REDIS_TRANSACTION_CACHE_KEY).Bytes()
-	if err != nil {
-		return nil, err
-	}
-
-	var transactions []models.Transaction
-	err = json.Unmarshal(transactionBytes, &transactions)
-	if err != nil {
-		return nil, err
-	}
-
-	return transactions, nil
+func (r *transactionRedis) GetTransactionCache(ctx context.Context, page models.PageQuery) ([]models.Transaction, *models.Pagination, error) {
+	return getPageCache[models.Transaction](ctx, r.client, REDIS_TRANSACTION_CACHE_KEY, page)
 }
 
 func (r *transactionRedis) Close() error {
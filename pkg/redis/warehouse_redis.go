@@ -10,13 +10,12 @@ import (
 	"errors"
 	"context"
 	"strconv"
-	"encoding/json"
 )
 
 type WarehouseRedis interface {
 	DeleteWarehouseCache(ctx context.Context) error
-	SetWarehouseCache(ctx context.Context, warehouses []models.Warehouse) error
-	GetWarehouseCache(ctx context.Context) ([]models.Warehouse, error)
+	SetWarehouseCache(ctx context.Context, page models.PageQuery, warehouses []models.Warehouse, pagination *models.Pagination) error
+	GetWarehouseCache(ctx context.Context, page models.PageQuery) ([]models.Warehouse, *models.Pagination, error)
 	Close() error
 }
 
@@ -74,31 +73,15 @@ func GetWarehouseRedis(ctx context.Context) (WarehouseRedis, error) {
 
 
 func (r *warehouseRedis) DeleteWarehouseCache(ctx context.Context) error {
-	return r.client.Del(ctx, REDIS_WAREHOUSE_CACHE_KEY).Err()
+	return deletePageCache(ctx, r.client, REDIS_WAREHOUSE_CACHE_KEY)
 }
 
-func (r *warehouseRedis) SetWarehouseCache(ctx context.Context, warehouses []models.Warehouse) error {
-	warehouseBytes, err := json.Marshal(warehouses)
-	if err != nil {
-		return err
-	}
-
-	return r.client.Set(ctx, REDIS_WAREHOUSE_CACHE_KEY, warehouseBytes, 0).Err()
+func (r *warehouseRedis) SetWarehouseCache(ctx context.Context, page models.PageQuery, warehouses []models.Warehouse, pagination *models.Pagination) error {
+	return setPageCache(ctx, r.client, REDIS_WAREHOUSE_CACHE_KEY, page, warehouses, pagination)
 }
 
-func (r *warehouseRedis) GetWarehouseCache(ctx context.Context) ([]models.Warehouse, error) {
-	warehouseBytes, err := r.client.Get(ctx, REDIS_WAREHOUSE_CACHE_KEY).Bytes()
-	if err != nil {
-		return nil, err
-	}
-
-	var warehouses []models.Warehouse
-	err = json.Unmarshal(warehouseBytes, &warehouses)
-	if err != nil {
-		return nil, err
-	}
-
-	return warehouses, nil
+func (r *warehouseRedis) GetWarehouseCache(ctx context.Context, page models.PageQuery) ([]models.Warehouse, *models.Pagination, error) {
+	return getPageCache[models.Warehouse](ctx, r.client, REDIS_WAREHOUSE_CACHE_KEY, page)
 }
 
 func (r *warehouseRedis) Close() error {
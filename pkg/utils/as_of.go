@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"errors"
+	"time"
+)
+
+// 시점 조회 기준 시각 파싱 ( RFC3339 또는 날짜만 지정 시 해당 날짜의 마지막 시각 )
+func ParseAsOf(value string) (time.Time, error) {
+	if asOf, err := time.Parse(time.RFC3339, value); err == nil {
+		return asOf, nil
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return time.Time{}, errors.New("as_of 는 RFC3339 또는 YYYY-MM-DD 형식이어야 합니다")
+	}
+
+	return date.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+}
@@ -0,0 +1,44 @@
+package utils
+
+// GTIN(EAN-8, UPC-A, EAN-13, GTIN-14) 자리수와 체크 디지트 검증
+func IsValidGTIN(code string, length int) bool {
+	if len(code) != length {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < length-1; i++ {
+		if code[i] < '0' || code[i] > '9' {
+			return false
+		}
+
+		// 체크 디지트 바로 앞 자리부터 3, 1 가중치를 번갈아 적용
+		digit := int(code[i] - '0')
+		if (length-1-i)%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	last := code[length-1]
+	if last < '0' || last > '9' {
+		return false
+	}
+
+	return (10-sum%10)%10 == int(last-'0')
+}
+
+// Code128 로 표현 가능한 출력 가능 ASCII 문자열 ( 1 ~ 80자 )
+func IsValidCode128(code string) bool {
+	if len(code) == 0 || len(code) > 80 {
+		return false
+	}
+
+	for i := 0; i < len(code); i++ {
+		if code[i] < 32 || code[i] > 126 {
+			return false
+		}
+	}
+
+	return true
+}
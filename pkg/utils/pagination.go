@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"github.com/jhphon0730/StockFlow/internal/models"
+
+	"github.com/gin-gonic/gin"
+
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 목록 조회 조건 파싱 ( page, size, cursor, sort, order / sortFields 에 없는 정렬 컬럼, 정렬 조건이 다른 cursor 는 오류 )
+// - size 를 생략하면 page, cursor 가 있을 때 DEFAULT_PAGE_SIZE, 없으면 defaultSize ( 0 이면 기존 목록 조회처럼 전체 조회 )
+func GetPageQuery(c *gin.Context, sortFields []string, defaultSize int) (models.PageQuery, error) {
+	page := models.PageQuery{
+		Page:  1,
+		Sort:  sortFields[0],
+		Order: models.SORT_ORDER_ASC,
+	}
+
+	if value := c.Query("page"); value != "" {
+		pageNumber, err := strconv.Atoi(value)
+		if err != nil || pageNumber < 1 {
+			return page, errors.New("page 는 1 이상이어야 합니다")
+		}
+		page.Page = pageNumber
+	}
+
+	if value := c.Query("size"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 1 || size > models.MAX_PAGE_SIZE {
+			return page, fmt.Errorf("size 는 1 이상 %d 이하여야 합니다", models.MAX_PAGE_SIZE)
+		}
+		page.Size = size
+	}
+
+	if value := c.Query("sort"); value != "" {
+		allowed := false
+		for _, field := range sortFields {
+			if field == value {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return page, fmt.Errorf("sort 는 %s 중 하나여야 합니다", strings.Join(sortFields, ", "))
+		}
+		page.Sort = value
+	}
+
+	if value := strings.ToLower(c.Query("order")); value != "" {
+		if value != models.SORT_ORDER_ASC && value != models.SORT_ORDER_DESC {
+			return page, errors.New("order 는 asc 또는 desc 이어야 합니다")
+		}
+		page.Order = value
+	}
+
+	// cursor 는 발급 시점과 같은 정렬 조건으로만 사용
+	if value := c.Query("cursor"); value != "" {
+		cursor, err := models.DecodeCursor(value)
+		if err != nil {
+			return page, err
+		}
+		if cursor.Sort != page.Sort || cursor.Order != page.Order {
+			return page, fmt.Errorf("cursor 의 정렬 조건(%s %s)이 요청한 정렬 조건(%s %s)과 다릅니다", cursor.Sort, cursor.Order, page.Sort, page.Order)
+		}
+		page.Cursor = cursor
+	}
+
+	if page.Size == 0 {
+		if c.Query("page") != "" || page.Cursor != nil {
+			page.Size = models.DEFAULT_PAGE_SIZE
+		} else {
+			page.Size = defaultSize
+		}
+	}
+
+	return page, nil
+}
@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+// 여러 재고내역을 하나로 묶기 위한 참조 값 생성 ( 예: TRANSFER-1A2B3C4D5E6F7A8B )
+func GenerateReference(prefix string) (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return prefix + "-" + strings.ToUpper(hex.EncodeToString(buf)), nil
+}
@@ -33,6 +33,52 @@ func GetProductSearchQuery(c *gin.Context) map[string]interface{} {
 		querys["sku"] = sku
 	}
 
+	// 하위 분류 포함
+	if categoryID := c.Query("category_id"); categoryID != "" {
+		querys["category_id"] = categoryID
+	}
+
+	if parentID := c.Query("parent_id"); parentID != "" {
+		querys["parent_id"] = parentID
+	}
+
+	// 속성 조건 ( 예: attr[color]=red&attr[size]=M )
+	if attributes := c.QueryMap("attr"); len(attributes) > 0 {
+		querys["attributes"] = attributes
+	}
+
+	return querys
+}
+
+func GetCategorySearchQuery(c *gin.Context) map[string]interface{} {
+	querys := make(map[string]interface{})
+
+	if name := c.Query("name"); name != "" {
+		querys["name"] = name
+	}
+
+	if parentID := c.Query("parent_id"); parentID != "" {
+		querys["parent_id"] = parentID
+	}
+
+	return querys
+}
+
+func GetBarcodeSearchQuery(c *gin.Context) map[string]interface{} {
+	querys := make(map[string]interface{})
+
+	if productID := c.Query("product_id"); productID != "" {
+		querys["product_id"] = productID
+	}
+
+	if symbology := c.Query("symbology"); symbology != "" {
+		querys["symbology"] = symbology
+	}
+
+	if code := c.Query("code"); code != "" {
+		querys["code"] = code
+	}
+
 	return querys
 }
 
@@ -61,5 +107,157 @@ func GetTransactionSearchQuery(c *gin.Context) map[string]interface{} {
 		querys["type"] = transactionType
 	}
 
+	if reference := c.Query("reference"); reference != "" {
+		querys["reference"] = reference
+	}
+
+	return querys
+}
+
+func GetOrderSearchQuery(c *gin.Context) map[string]interface{} {
+	querys := make(map[string]interface{})
+
+	if warehouseID := c.Query("warehouse_id"); warehouseID != "" {
+		querys["warehouse_id"] = warehouseID
+	}
+
+	if status := c.Query("status"); status != "" {
+		querys["status"] = status
+	}
+
+	if customer := c.Query("customer"); customer != "" {
+		querys["customer"] = customer
+	}
+
+	return querys
+}
+
+func GetTransferOrderSearchQuery(c *gin.Context) map[string]interface{} {
+	querys := make(map[string]interface{})
+
+	if sourceWarehouseID := c.Query("source_warehouse_id"); sourceWarehouseID != "" {
+		querys["source_warehouse_id"] = sourceWarehouseID
+	}
+
+	if destinationWarehouseID := c.Query("destination_warehouse_id"); destinationWarehouseID != "" {
+		querys["destination_warehouse_id"] = destinationWarehouseID
+	}
+
+	if status := c.Query("status"); status != "" {
+		querys["status"] = status
+	}
+
+	return querys
+}
+
+func GetReservationSearchQuery(c *gin.Context) map[string]interface{} {
+	querys := make(map[string]interface{})
+
+	if inventoryID := c.Query("inventory_id"); inventoryID != "" {
+		querys["inventory_id"] = inventoryID
+	}
+
+	if status := c.Query("status"); status != "" {
+		querys["status"] = status
+	}
+
+	if ownerReference := c.Query("owner_reference"); ownerReference != "" {
+		querys["owner_reference"] = ownerReference
+	}
+
+	return querys
+}
+
+func GetBinLocationSearchQuery(c *gin.Context) map[string]interface{} {
+	querys := make(map[string]interface{})
+
+	if warehouseID := c.Query("warehouse_id"); warehouseID != "" {
+		querys["warehouse_id"] = warehouseID
+	}
+
+	if zone := c.Query("zone"); zone != "" {
+		querys["zone"] = zone
+	}
+
+	if aisle := c.Query("aisle"); aisle != "" {
+		querys["aisle"] = aisle
+	}
+
+	if code := c.Query("code"); code != "" {
+		querys["code"] = code
+	}
+
+	if codePrefix := c.Query("code_prefix"); codePrefix != "" {
+		querys["code_prefix"] = codePrefix
+	}
+
+	return querys
+}
+
+func GetStockAlertSearchQuery(c *gin.Context) map[string]interface{} {
+	querys := make(map[string]interface{})
+
+	if warehouseID := c.Query("warehouse_id"); warehouseID != "" {
+		querys["warehouse_id"] = warehouseID
+	}
+
+	if inventoryID := c.Query("inventory_id"); inventoryID != "" {
+		querys["inventory_id"] = inventoryID
+	}
+
+	if level := c.Query("level"); level != "" {
+		querys["level"] = level
+	}
+
+	if acknowledged := c.Query("acknowledged"); acknowledged != "" {
+		querys["acknowledged"] = acknowledged == "true"
+	}
+
+	return querys
+}
+
+func GetSupplierSearchQuery(c *gin.Context) map[string]interface{} {
+	querys := make(map[string]interface{})
+
+	if name := c.Query("name"); name != "" {
+		querys["name"] = name
+	}
+
+	if email := c.Query("email"); email != "" {
+		querys["email"] = email
+	}
+
+	return querys
+}
+
+func GetPurchaseOrderSearchQuery(c *gin.Context) map[string]interface{} {
+	querys := make(map[string]interface{})
+
+	if supplierID := c.Query("supplier_id"); supplierID != "" {
+		querys["supplier_id"] = supplierID
+	}
+
+	if warehouseID := c.Query("warehouse_id"); warehouseID != "" {
+		querys["warehouse_id"] = warehouseID
+	}
+
+	if status := c.Query("status"); status != "" {
+		querys["status"] = status
+	}
+
+	return querys
+}
+
+func GetStocktakeSearchQuery(c *gin.Context) map[string]interface{} {
+	querys := make(map[string]interface{})
+
+	if warehouseID := c.Query("warehouse_id"); warehouseID != "" {
+		querys["warehouse_id"] = warehouseID
+	}
+
+	if status := c.Query("status"); status != "" {
+		querys["status"] = status
+	}
+
 	return querys
 }